@@ -29,13 +29,19 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	_ "github.com/ratmirtech/vector-rules-service/docs" // Import generated docs
 	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
 	"github.com/ratmirtech/vector-rules-service/internal/infra/db"
 	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/rerank"
 	"github.com/ratmirtech/vector-rules-service/internal/repository"
 	grpcTransport "github.com/ratmirtech/vector-rules-service/internal/transport/grpc"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
 	httpTransport "github.com/ratmirtech/vector-rules-service/internal/transport/http"
 	"github.com/ratmirtech/vector-rules-service/internal/usecase"
 )
@@ -61,23 +67,94 @@ func main() {
 	// Initialize repositories
 	ruleRepo := repository.NewRuleRepository(dbPool)
 	ruleTypeRepo := repository.NewRuleTypeRepository(dbPool)
+	embeddingCacheRepo := repository.NewEmbeddingCacheRepository(dbPool)
+	reindexJobRepo := repository.NewReindexJobRepository(dbPool)
 
-	// Initialize embedding provider (mock implementation)
-	embeddingProvider := embeddings.NewMockEmbeddingProvider(1536) // OpenAI ada-002 dimensions
+	// Initialize embedding provider chain
+	embeddingProvider, modelProviders, err := newEmbeddingProvider(ctx, &cfg.Embedding, &cfg.EmbeddingFallback, &cfg.Resilience, embeddingCacheRepo)
+	if err != nil {
+		log.Fatal("Failed to initialize embedding provider:", err)
+	}
+
+	// Initialize the shadow model written alongside the primary one during model migrations
+	var additionalProviders []domain.EmbeddingProvider
+	if cfg.EmbeddingShadow.Provider != "" {
+		shadowProvider, shadowModels, err := newEmbeddingProvider(ctx, &cfg.EmbeddingShadow, nil, &cfg.Resilience, embeddingCacheRepo)
+		if err != nil {
+			log.Fatal("Failed to initialize shadow embedding provider:", err)
+		}
+		if shadowProvider.Model() == embeddingProvider.Model() {
+			log.Fatalf("Shadow embedding model must differ from the primary model %s", embeddingProvider.Model())
+		}
+		additionalProviders = append(additionalProviders, shadowProvider)
+		modelProviders = append(modelProviders, shadowModels...)
+	}
 
 	// Initialize services
-	ruleService := usecase.NewRuleService(ruleRepo, ruleTypeRepo, embeddingProvider)
-	ruleTypeService := usecase.NewRuleTypeService(ruleTypeRepo)
+	chunking := usecase.ChunkingOptions{
+		Strategy: cfg.Chunking.Strategy,
+		MaxChars: cfg.Chunking.MaxChars,
+		Overlap:  cfg.Chunking.Overlap,
+	}
+	search := usecase.SearchOptions{
+		Metric:       domain.DistanceMetric(cfg.Search.DistanceMetric),
+		ExplainPlans: cfg.Search.Debug,
+	}
+	if !search.Metric.IsValid() {
+		log.Fatalf("Unknown distance metric %s", search.Metric)
+	}
+
+	// Vectors of every configured model are searched, fallback vectors included.
+	// Indexes are built in the background; searches scan the chunks until they are ready.
+	if cfg.Search.AutoIndex {
+		go ensureVectorIndexes(ctx, ruleRepo, modelProviders, search.Metric)
+	}
+	calibration := usecase.ScoreCalibration{
+		Midpoint:  cfg.Scoring.CalibrationMidpoint,
+		Steepness: cfg.Scoring.CalibrationSteepness,
+	}
+	dedup := usecase.DedupOptions{
+		Policy:    domain.DedupPolicy(cfg.Dedup.Policy),
+		Threshold: cfg.Dedup.Threshold,
+	}
+	if !dedup.Policy.IsValid() {
+		log.Fatalf("Unknown dedup policy %s", dedup.Policy)
+	}
+	reranker, err := rerank.NewReranker(&cfg.Rerank)
+	if err != nil {
+		log.Fatal("Failed to initialize reranker:", err)
+	}
+	ruleService := usecase.NewRuleService(ruleRepo, ruleTypeRepo, chunking, search, calibration, dedup, reranker, embeddingProvider, additionalProviders...)
+
+	// Background reindex jobs are stopped on shutdown and resumed on the next start
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+	reindexService := usecase.NewReindexService(jobsCtx, ruleRepo, ruleTypeRepo, reindexJobRepo, chunking, embeddingProvider, additionalProviders...)
+	if err := reindexService.ResumeReindexJobs(ctx); err != nil {
+		log.Printf("Failed to resume reindex jobs: %v", err)
+	}
+
+	ruleTypeService := usecase.NewRuleTypeService(ruleTypeRepo, reindexService)
 
 	// Initialize HTTP server
-	httpServer := httpTransport.NewServer(ruleService, ruleTypeService)
+	embeddingStats, _ := embeddingProvider.(domain.EmbeddingStatsReporter)
+	httpServer := httpTransport.NewServer(ruleService, ruleTypeService, embeddingStats, reindexService)
 
 	// Initialize gRPC server
 	grpcServer := grpc.NewServer()
-	ruleRetrievalServer := grpcTransport.NewRuleRetrievalServer(ruleService)
-	// Note: This line will work after running `make proto`
-	// pb.RegisterRuleRetrievalServiceServer(grpcServer, ruleRetrievalServer)
-	_ = ruleRetrievalServer // Prevent unused variable error
+	pb.RegisterRuleRetrievalServiceServer(grpcServer, grpcTransport.NewRuleRetrievalServer(ruleService))
+	pb.RegisterReindexServiceServer(grpcServer, grpcTransport.NewReindexServer(reindexService))
+	pb.RegisterRuleAdminServiceServer(grpcServer, grpcTransport.NewRuleAdminServer(ruleService))
+	pb.RegisterRuleTypeServiceServer(grpcServer, grpcTransport.NewRuleTypeServer(ruleTypeService))
+
+	// Health checking and server reflection for grpcurl and load balancers
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for service := range grpcServer.GetServiceInfo() {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
 
 	// Start HTTP server in goroutine
 	go func() {
@@ -108,6 +185,9 @@ func main() {
 
 	log.Println("Shutting down servers...")
 
+	// Interrupted reindex jobs keep their cursor and are resumed on the next start
+	stopJobs()
+
 	// Graceful shutdown with timeout
 	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -118,7 +198,74 @@ func main() {
 	}
 
 	// Shutdown gRPC server
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
 
 	log.Println("Servers stopped")
 }
+
+// newEmbeddingProvider builds the embedding provider chain: every configured provider is
+// wrapped with a micro-batching dispatcher and its own cache, then the primary and the
+// optional fallback are combined into a failover provider with retries and circuit breakers.
+// The providers of the chain are returned as well, their vectors are stored under their own models.
+func newEmbeddingProvider(
+	ctx context.Context,
+	primary *config.EmbeddingConfig,
+	fallback *config.EmbeddingConfig,
+	resilience *config.ResilienceConfig,
+	cacheRepo domain.EmbeddingCacheRepository,
+) (domain.EmbeddingProvider, []domain.EmbeddingProvider, error) {
+	providerConfigs := []config.EmbeddingConfig{*primary}
+	if fallback != nil && fallback.Provider != "" {
+		providerConfigs = append(providerConfigs, *fallback)
+	}
+
+	providers := make([]domain.EmbeddingProvider, 0, len(providerConfigs))
+	for i := range providerConfigs {
+		providerCfg := &providerConfigs[i]
+
+		provider, err := embeddings.NewProvider(providerCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if providerCfg.BatchWindow > 0 {
+			provider = embeddings.NewBatchingEmbeddingProvider(ctx, provider, providerCfg.BatchWindow, providerCfg.MaxBatchSize)
+		}
+
+		var cacheStore domain.EmbeddingCacheRepository
+		if providerCfg.CachePersistent {
+			cacheStore = cacheRepo
+		}
+		providers = append(providers, embeddings.NewCachedEmbeddingProvider(provider, cacheStore, providerCfg.CacheSize))
+
+		log.Printf("Using %s embedding provider (model %s, priority %d)", providerCfg.Provider, provider.Model(), i)
+	}
+
+	failover, err := embeddings.NewFailoverEmbeddingProvider(providers, embeddings.FailoverOptions{
+		MaxRetries:       resilience.MaxRetries,
+		RetryBaseDelay:   resilience.RetryBaseDelay,
+		RetryMaxDelay:    resilience.RetryMaxDelay,
+		BreakerThreshold: resilience.BreakerThreshold,
+		BreakerCooldown:  resilience.BreakerCooldown,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return failover, providers, nil
+}
+
+// ensureVectorIndexes creates missing vector indexes of the models for the metric, one at a time
+func ensureVectorIndexes(ctx context.Context, ruleRepo domain.RuleRepository, providers []domain.EmbeddingProvider, metric domain.DistanceMetric) {
+	for _, provider := range providers {
+		log.Printf("Checking vector index of model %s (%d dimensions, metric %s)", provider.Model(), provider.Dimensions(), metric)
+		created, err := ruleRepo.EnsureVectorIndex(ctx, provider.Model(), provider.Dimensions(), metric)
+		if err != nil {
+			log.Printf("Failed to ensure vector index of model %s: %v", provider.Model(), err)
+			continue
+		}
+		if created {
+			log.Printf("Created vector index of model %s", provider.Model())
+		}
+	}
+}
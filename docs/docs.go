@@ -1,13 +1,17 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
 package docs
 
 import "github.com/swaggo/swag"
 
 const docTemplate = `{
-    "schemes": {{ marshal .Schemes }},
+    "schemes": [[ marshal .Schemes ]],
+    "produces": [
+        "application/json"
+    ],
     "swagger": "2.0",
     "info": {
-        "description": "{{escape .Description}}",
-        "title": "{{.Title}}",
+        "description": "[[escape .Description]]",
+        "title": "[[.Title]]",
         "termsOfService": "http://swagger.io/terms/",
         "contact": {
             "name": "API Support",
@@ -18,12 +22,1743 @@ const docTemplate = `{
             "name": "MIT",
             "url": "https://opensource.org/licenses/MIT"
         },
-        "version": "{{.Version}}"
+        "version": "[[.Version]]"
     },
-    "host": "{{.Host}}",
-    "basePath": "{{.BasePath}}",
-    "paths": {},
-    "definitions": {}
+    "host": "[[.Host]]",
+    "basePath": "[[.BasePath]]",
+    "paths": {
+        "/embeddings/stats": {
+            "get": {
+                "description": "Get cache hit/miss counters and other runtime statistics of the embedding provider",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "embeddings"
+                ],
+                "summary": "Get embedding provider statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/reindex/jobs": {
+            "get": {
+                "description": "List reindex jobs with pagination, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reindex"
+                ],
+                "summary": "List reindex jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start a background job regenerating rule embeddings for a model",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reindex"
+                ],
+                "summary": "Start a reindex job",
+                "parameters": [
+                    {
+                        "description": "Reindex job parameters",
+                        "name": "job",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerStartReindexRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerReindexJob"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reindex/jobs/{id}": {
+            "get": {
+                "description": "Get status and progress of a reindex job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reindex"
+                ],
+                "summary": "Get reindex job by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reindex job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerReindexJob"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reindex/jobs/{id}/cancel": {
+            "post": {
+                "description": "Cancel a running reindex job; embeddings already written are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reindex"
+                ],
+                "summary": "Cancel a reindex job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reindex job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerReindexJob"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rule-types": {
+            "get": {
+                "description": "List rule types with optional pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule-types"
+                ],
+                "summary": "List rule types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new rule type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule-types"
+                ],
+                "summary": "Create a new rule type",
+                "parameters": [
+                    {
+                        "description": "Rule type creation request",
+                        "name": "ruleType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerCreateRuleTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerRuleType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rule-types/{id}": {
+            "get": {
+                "description": "Get a specific rule type by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule-types"
+                ],
+                "summary": "Get rule type by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerRuleType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing rule type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule-types"
+                ],
+                "summary": "Update a rule type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rule type update request",
+                        "name": "ruleType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerUpdateRuleTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerRuleType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a rule type by ID",
+                "tags": [
+                    "rule-types"
+                ],
+                "summary": "Delete a rule type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules": {
+            "get": {
+                "description": "List rules with optional pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "List rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type names to include, repeated or comma separated",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type IDs to include",
+                        "name": "type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type names to exclude",
+                        "name": "exclude_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type IDs to exclude",
+                        "name": "exclude_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Metadata filter on rule content as JSON, e.g. {\\",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new rule with embedding generation; near-duplicates of the same type are handled by the dedup policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Create a new rule",
+                "parameters": [
+                    {
+                        "description": "Rule creation request",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerCreateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSavedRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerDuplicateRulesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/search": {
+            "post": {
+                "description": "Vector or hybrid (vector + full-text) similarity search over rules; several queries are combined with the fusion strategy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Search similar rules",
+                "parameters": [
+                    {
+                        "description": "Search request",
+                        "name": "query",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSearchRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSearchRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/search/batch": {
+            "post": {
+                "description": "Runs independent searches, embedding all their queries at once; a failing group does not fail the others",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Search similar rules in batch",
+                "parameters": [
+                    {
+                        "description": "Batch search request",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSearchRulesBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSearchRulesBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/{id}": {
+            "get": {
+                "description": "Get a specific rule by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Get rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing rule and regenerate embedding; near-duplicates of the same type are handled by the dedup policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Update a rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rule update request",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerUpdateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSavedRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerDuplicateRulesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a rule by ID",
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Delete a rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/{id}/similar": {
+            "get": {
+                "description": "Similarity search with the stored embedding of the rule, excluding the rule itself; no embedding is generated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Find rules similar to a rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of rules to return",
+                        "name": "n",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Embedding model to compare with, defaults to the primary model",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Distance metric: cosine, l2 or inner_product",
+                        "name": "metric",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type names to include, repeated or comma separated",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type IDs to include",
+                        "name": "type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type names to exclude",
+                        "name": "exclude_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Rule type IDs to exclude",
+                        "name": "exclude_type_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerSearchRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.SwaggerErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "http.SwaggerBatchSearchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid input: queries cannot be empty"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleMatch"
+                    }
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                }
+            }
+        },
+        "http.SwaggerCreateRuleRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "type"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "{\"description\":\"Sample rule content\"}"
+                },
+                "dedup_policy": {
+                    "type": "string",
+                    "enum": [
+                        "reject",
+                        "warn",
+                        "allow"
+                    ],
+                    "example": "reject"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "security"
+                }
+            }
+        },
+        "http.SwaggerCreateRuleTypeRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "embedding_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "/description",
+                        "/tags"
+                    ]
+                },
+                "embedding_template": {
+                    "type": "string",
+                    "example": "{{.description}}. Tags: {{join .tags \", \"}}"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "security"
+                }
+            }
+        },
+        "http.SwaggerDuplicateRulesResponse": {
+            "type": "object",
+            "properties": {
+                "duplicates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleMatch"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "rule duplicates existing rules 12"
+                }
+            }
+        },
+        "http.SwaggerErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request data"
+                }
+            }
+        },
+        "http.SwaggerListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {}
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "http.SwaggerMatchExplanation": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number",
+                    "example": 0.18
+                },
+                "filters": {
+                    "$ref": "#/definitions/http.SwaggerSearchFilters"
+                },
+                "final_rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metric": {
+                    "type": "string",
+                    "example": "cosine"
+                },
+                "plan": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "query_similarities": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        0.82,
+                        0.41
+                    ]
+                },
+                "retrieval_rank": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "http.SwaggerMetadataFilter": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerMetadataFilter"
+                    }
+                },
+                "gt": {},
+                "gte": {},
+                "lt": {},
+                "lte": {},
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "eq",
+                        "ne",
+                        "in",
+                        "range",
+                        "exists",
+                        "contains",
+                        "and",
+                        "or"
+                    ],
+                    "example": "eq"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/required"
+                },
+                "value": {},
+                "values": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "http.SwaggerReindexJob": {
+            "type": "object",
+            "properties": {
+                "batch_size": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:10:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_rule_id": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "max_rules_per_second": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "model": {
+                    "type": "string",
+                    "example": "text-embedding-3-small"
+                },
+                "processed": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "rule_type": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "stale"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "completed",
+                        "failed",
+                        "canceled"
+                    ],
+                    "example": "running"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.SwaggerRule": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "{\"description\":\"Sample rule content\"}"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "embeddings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleEmbedding"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rule_type_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rule_type_name": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.SwaggerRuleChunk": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Validate email format"
+                }
+            }
+        },
+        "http.SwaggerRuleEmbedding": {
+            "type": "object",
+            "properties": {
+                "chunks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleChunk"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "dimensions": {
+                    "type": "integer",
+                    "example": 1536
+                },
+                "model": {
+                    "type": "string",
+                    "example": "text-embedding-3-small"
+                }
+            }
+        },
+        "http.SwaggerRuleMatch": {
+            "type": "object",
+            "properties": {
+                "chunk": {
+                    "$ref": "#/definitions/http.SwaggerRuleChunk"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "{\"description\":\"Sample rule content\"}"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "embeddings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleEmbedding"
+                    }
+                },
+                "explanation": {
+                    "$ref": "#/definitions/http.SwaggerMatchExplanation"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "model": {
+                    "type": "string",
+                    "example": "text-embedding-3-small"
+                },
+                "rerank_score": {
+                    "type": "number",
+                    "example": 0.95
+                },
+                "rule_type_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rule_type_name": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 0.95
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "vector_score": {
+                    "type": "number",
+                    "example": 0.82
+                }
+            }
+        },
+        "http.SwaggerRuleType": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "embedding_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "/description",
+                        "/tags"
+                    ]
+                },
+                "embedding_template": {
+                    "type": "string",
+                    "example": "{{.description}}. Tags: {{join .tags \", \"}}"
+                },
+                "embedding_template_updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.SwaggerRuleTypeFilter": {
+            "type": "object",
+            "properties": {
+                "exclude": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "exclude_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "include": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "validation"
+                    ]
+                },
+                "include_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "http.SwaggerSavedRule": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "{\"description\":\"Sample rule content\"}"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "embeddings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleEmbedding"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "possible_duplicates": {
+                    "description": "With the warn dedup policy",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleMatch"
+                    }
+                },
+                "rule_type_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rule_type_name": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.SwaggerSearchFilters": {
+            "type": "object",
+            "properties": {
+                "metadata": {
+                    "$ref": "#/definitions/http.SwaggerMetadataFilter"
+                },
+                "min_score": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "type_quotas": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "types": {
+                    "$ref": "#/definitions/http.SwaggerRuleTypeFilter"
+                }
+            }
+        },
+        "http.SwaggerSearchRulesBatchRequest": {
+            "type": "object",
+            "required": [
+                "groups"
+            ],
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerSearchRulesRequest"
+                    }
+                }
+            }
+        },
+        "http.SwaggerSearchRulesBatchResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerBatchSearchResult"
+                    }
+                }
+            }
+        },
+        "http.SwaggerSearchRulesRequest": {
+            "type": "object",
+            "required": [
+                "n",
+                "queries"
+            ],
+            "properties": {
+                "alpha": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.7
+                },
+                "exclude_type_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "exclude_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "filtering"
+                    ]
+                },
+                "explain": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "filter": {
+                    "$ref": "#/definitions/http.SwaggerMetadataFilter"
+                },
+                "fusion": {
+                    "type": "string",
+                    "enum": [
+                        "average",
+                        "max",
+                        "rrf",
+                        "sum"
+                    ],
+                    "example": "rrf"
+                },
+                "metric": {
+                    "type": "string",
+                    "enum": [
+                        "cosine",
+                        "l2",
+                        "inner_product"
+                    ],
+                    "example": "cosine"
+                },
+                "min_score": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "mmr_lambda": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.7
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "vector",
+                        "hybrid",
+                        "hybrid_rrf"
+                    ],
+                    "example": "hybrid"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "text-embedding-3-small"
+                },
+                "n": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "queries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "email validation",
+                        "check email format"
+                    ]
+                },
+                "rerank": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "score_mode": {
+                    "type": "string",
+                    "enum": [
+                        "raw",
+                        "calibrated"
+                    ],
+                    "example": "calibrated"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "validation"
+                },
+                "type_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "type_quotas": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "validation",
+                        "business_logic"
+                    ]
+                }
+            }
+        },
+        "http.SwaggerSearchRulesResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.SwaggerRuleMatch"
+                    }
+                }
+            }
+        },
+        "http.SwaggerStartReindexRequest": {
+            "type": "object",
+            "properties": {
+                "batch_size": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "max_rules_per_second": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "model": {
+                    "type": "string",
+                    "example": "text-embedding-3-small"
+                },
+                "rule_type": {
+                    "type": "string",
+                    "example": "security"
+                },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "all",
+                        "missing",
+                        "stale"
+                    ],
+                    "example": "stale"
+                }
+            }
+        },
+        "http.SwaggerUpdateRuleRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "id",
+                "type"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "{\"description\":\"Updated rule content\"}"
+                },
+                "dedup_policy": {
+                    "type": "string",
+                    "enum": [
+                        "reject",
+                        "warn",
+                        "allow"
+                    ],
+                    "example": "reject"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "type": {
+                    "type": "string",
+                    "example": "security"
+                }
+            }
+        },
+        "http.SwaggerUpdateRuleTypeRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "clear_embedding_fields": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "clear_embedding_template": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "embedding_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "/description",
+                        "/tags"
+                    ]
+                },
+                "embedding_template": {
+                    "type": "string",
+                    "example": "{{.description}}. Tags: {{join .tags \", \"}}"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "updated-security"
+                },
+                "reindex": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        }
+    }
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
@@ -36,8 +1771,8 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "Микросервис для работы с векторной базой данных правил на PostgreSQL + pgvector",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
-	LeftDelim:        "{{",
-	RightDelim:       "}}",
+	LeftDelim:        "[[",
+	RightDelim:       "]]",
 }
 
 func init() {
@@ -0,0 +1,11 @@
+-- Persistent embedding cache keyed by sha256 of the embedded text and model name
+CREATE TABLE IF NOT EXISTS embedding_cache (
+    content_hash TEXT NOT NULL, -- hex-encoded sha256 of the text
+    model TEXT NOT NULL,
+    dimensions INT NOT NULL,
+    embedding vector NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (content_hash, model)
+);
+
+CREATE INDEX IF NOT EXISTS idx_embedding_cache_created_at ON embedding_cache(created_at);
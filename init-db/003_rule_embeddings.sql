@@ -0,0 +1,36 @@
+-- Embeddings of rules produced by different models
+CREATE TABLE IF NOT EXISTS rule_embeddings (
+    rule_id BIGINT NOT NULL REFERENCES rules(id) ON DELETE CASCADE,
+    model TEXT NOT NULL,
+    dimensions INT NOT NULL,
+    embedding vector NOT NULL, -- no fixed size: models of different dimensionality coexist
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (rule_id, model)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_embeddings_model ON rule_embeddings(model);
+
+-- Vector indexes are per model: an expression index over a fixed-size cast, restricted to the model.
+-- Add one for every model used in production, e.g. for the default model:
+-- Search queries inline the model name and the cast size, so generic plans of prepared statements can use it.
+CREATE INDEX IF NOT EXISTS idx_rule_embeddings_text_embedding_3_small ON rule_embeddings
+    USING hnsw ((embedding::vector(1536)) vector_cosine_ops)
+    WHERE model = 'text-embedding-3-small';
+
+-- Move embeddings from the legacy single column; their model is unknown
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'rules' AND column_name = 'embedding'
+    ) THEN
+        INSERT INTO rule_embeddings (rule_id, model, dimensions, embedding)
+        SELECT id, 'legacy', vector_dims(embedding), embedding
+        FROM rules
+        WHERE embedding IS NOT NULL
+        ON CONFLICT (rule_id, model) DO NOTHING;
+
+        DROP INDEX IF EXISTS idx_rules_embedding;
+        ALTER TABLE rules DROP COLUMN embedding;
+    END IF;
+END $$;
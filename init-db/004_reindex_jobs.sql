@@ -0,0 +1,24 @@
+-- Background jobs regenerating rule embeddings
+CREATE TABLE IF NOT EXISTS reindex_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    model TEXT NOT NULL,
+    scope TEXT NOT NULL,
+    rule_type TEXT,
+    batch_size INT NOT NULL,
+    max_rules_per_second INT NOT NULL DEFAULT 0,
+    status TEXT NOT NULL,
+    total INT NOT NULL DEFAULT 0,
+    processed INT NOT NULL DEFAULT 0,
+    last_rule_id BIGINT NOT NULL DEFAULT 0, -- resume cursor
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reindex_jobs_status ON reindex_jobs(status);
+
+CREATE TRIGGER update_reindex_jobs_updated_at
+    BEFORE UPDATE ON reindex_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,9 @@
+-- Per-rule-type extraction of the text embedded for rules.
+-- embedding_template (Go text/template) takes precedence over embedding_fields (JSON pointers);
+-- without both, string values of the rule content are concatenated.
+ALTER TABLE rule_types ADD COLUMN IF NOT EXISTS embedding_fields TEXT[];
+ALTER TABLE rule_types ADD COLUMN IF NOT EXISTS embedding_template TEXT;
+
+-- Embeddings created before this timestamp are stale for the reindex "stale" scope.
+-- Existing embeddings were built from raw JSON, so they become stale with the migration.
+ALTER TABLE rule_types ADD COLUMN IF NOT EXISTS embedding_template_updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
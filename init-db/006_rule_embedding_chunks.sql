@@ -0,0 +1,23 @@
+-- Chunks of long rule text embedded separately; rule_embeddings keeps the pooled vector
+CREATE TABLE IF NOT EXISTS rule_embedding_chunks (
+    rule_id BIGINT NOT NULL,
+    model TEXT NOT NULL,
+    chunk_index INT NOT NULL,
+    content TEXT, -- chunk text, NULL for vectors migrated from rule_embeddings
+    dimensions INT NOT NULL,
+    embedding vector NOT NULL,
+    PRIMARY KEY (rule_id, model, chunk_index),
+    FOREIGN KEY (rule_id, model) REFERENCES rule_embeddings(rule_id, model) ON DELETE CASCADE
+);
+
+-- Similarity search runs over chunks: add a vector index for every model used in production
+-- Search queries inline the model name and the cast size, so generic plans of prepared statements can use it.
+CREATE INDEX IF NOT EXISTS idx_rule_embedding_chunks_text_embedding_3_small ON rule_embedding_chunks
+    USING hnsw ((embedding::vector(1536)) vector_cosine_ops)
+    WHERE model = 'text-embedding-3-small';
+
+-- Existing embeddings become single-chunk rules
+INSERT INTO rule_embedding_chunks (rule_id, model, chunk_index, dimensions, embedding)
+SELECT rule_id, model, 0, dimensions, embedding
+FROM rule_embeddings
+ON CONFLICT (rule_id, model, chunk_index) DO NOTHING;
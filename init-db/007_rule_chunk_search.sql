@@ -0,0 +1,10 @@
+-- Full-text index over the embedded text of rule chunks for hybrid lexical + vector search.
+-- Both Russian and English configurations are applied, so stems of either language match.
+-- Chunks migrated without text stay empty until the rule is reindexed.
+ALTER TABLE rule_embedding_chunks ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('russian', COALESCE(content, '')) || to_tsvector('english', COALESCE(content, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_rule_embedding_chunks_search_vector ON rule_embedding_chunks
+    USING GIN (search_vector);
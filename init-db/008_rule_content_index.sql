@@ -0,0 +1,2 @@
+-- Metadata filters on rule content use containment (@>) to narrow candidates through this index
+CREATE INDEX IF NOT EXISTS idx_rules_content ON rules USING GIN (content jsonb_path_ops);
@@ -0,0 +1,11 @@
+-- Vector indexes serve one metric each: cosine (vector_cosine_ops) indexes are created with the tables,
+-- these serve searches with the l2 and inner_product metrics. Only indexes of metrics in use are needed,
+-- drop the others to speed up writes. Only the default model is indexed here: on start the service creates
+-- the index of every configured model for DISTANCE_METRIC (SEARCH_AUTO_INDEX).
+CREATE INDEX IF NOT EXISTS idx_rule_embedding_chunks_text_embedding_3_small_l2 ON rule_embedding_chunks
+    USING hnsw ((embedding::vector(1536)) vector_l2_ops)
+    WHERE model = 'text-embedding-3-small';
+
+CREATE INDEX IF NOT EXISTS idx_rule_embedding_chunks_text_embedding_3_small_ip ON rule_embedding_chunks
+    USING hnsw ((embedding::vector(1536)) vector_ip_ops)
+    WHERE model = 'text-embedding-3-small';
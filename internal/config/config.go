@@ -4,12 +4,25 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	Embedding EmbeddingConfig
+
+	// EmbeddingFallback is used when the primary provider fails; disabled when Provider is empty
+	EmbeddingFallback EmbeddingConfig
+	// EmbeddingShadow is an additional model written alongside the primary one; disabled when Provider is empty
+	EmbeddingShadow EmbeddingConfig
+	Resilience      ResilienceConfig
+	Chunking        ChunkingConfig
+	Search          SearchConfig
+	Scoring         ScoringConfig
+	Dedup           DedupConfig
+	Rerank          RerankConfig
 }
 
 // ServerConfig holds server-specific configuration
@@ -29,6 +42,71 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+// EmbeddingConfig holds embedding provider configuration
+type EmbeddingConfig struct {
+	Provider      string // mock, local or openai
+	BaseURL       string
+	APIKey        string
+	Model         string
+	Dimensions    int
+	MaxBatchSize  int // Maximum number of inputs per API request
+	MaxBatchChars int // Maximum total characters per API request, 0 means unlimited
+	Timeout       time.Duration
+
+	// RequestDimensions sends Dimensions to the API, set when DIMENSIONS is configured explicitly.
+	// Models without shortened embeddings (e.g. text-embedding-ada-002) reject the parameter.
+	RequestDimensions bool
+
+	BatchWindow time.Duration // Window for coalescing concurrent requests, 0 disables coalescing
+
+	CacheSize       int  // Capacity of the in-memory LRU cache, 0 disables it
+	CachePersistent bool // Enables the Postgres-backed cache tier
+}
+
+// ResilienceConfig holds retry and circuit breaker settings of the embedding provider chain
+type ResilienceConfig struct {
+	MaxRetries       int // Retries of transient errors per provider
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
+	BreakerThreshold int // Consecutive failures opening the circuit
+	BreakerCooldown  time.Duration
+}
+
+// ChunkingConfig holds settings for splitting long rule text into separately embedded chunks
+type ChunkingConfig struct {
+	Strategy string // size, fields or none
+	MaxChars int    // Maximum characters per chunk
+	Overlap  int    // Characters repeated between consecutive size-based chunks
+}
+
+// SearchConfig holds similarity search settings
+type SearchConfig struct {
+	DistanceMetric string // cosine, l2 or inner_product; needs a vector index of the matching operator class
+	Debug          bool   // Adds execution plans of search queries to explained results
+	AutoIndex      bool   // Creates a vector index of every configured model for DistanceMetric on start
+}
+
+// ScoringConfig holds the logistic calibration of similarity scores
+type ScoringConfig struct {
+	CalibrationMidpoint  float64 // Raw similarity mapped to 0.5
+	CalibrationSteepness float64 // Slope of the curve, 0 disables calibrated scores
+}
+
+// DedupConfig holds near-duplicate detection settings of rule create and update
+type DedupConfig struct {
+	Policy    string  // reject, warn or allow; used when a request sets no policy
+	Threshold float64 // Similarity at which rules of the same type are duplicates
+}
+
+// RerankConfig holds reranker configuration
+type RerankConfig struct {
+	Provider string // none, lexical or http
+	BaseURL  string // Base URL of a Cohere/Jina-compatible /rerank API
+	APIKey   string
+	Model    string
+	Timeout  time.Duration
+}
+
 // Load loads configuration from environment variables with defaults
 func Load() (*Config, error) {
 	config := &Config{
@@ -45,11 +123,83 @@ func Load() (*Config, error) {
 			DBName:   getEnv("DB_NAME", "vector_rules"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
+		Resilience: ResilienceConfig{
+			MaxRetries:       getEnvAsInt("EMBEDDING_MAX_RETRIES", 3),
+			RetryBaseDelay:   getEnvAsDuration("EMBEDDING_RETRY_BASE_DELAY", 200*time.Millisecond),
+			RetryMaxDelay:    getEnvAsDuration("EMBEDDING_RETRY_MAX_DELAY", 5*time.Second),
+			BreakerThreshold: getEnvAsInt("EMBEDDING_BREAKER_THRESHOLD", 5),
+			BreakerCooldown:  getEnvAsDuration("EMBEDDING_BREAKER_COOLDOWN", 30*time.Second),
+		},
+		Chunking: ChunkingConfig{
+			Strategy: getEnv("CHUNK_STRATEGY", "size"),
+			MaxChars: getEnvAsInt("CHUNK_MAX_CHARS", 2000),
+			Overlap:  getEnvAsInt("CHUNK_OVERLAP", 200),
+		},
+		Search: SearchConfig{
+			DistanceMetric: getEnv("DISTANCE_METRIC", "cosine"),
+			Debug:          getEnvAsBool("SEARCH_DEBUG", false),
+			AutoIndex:      getEnvAsBool("SEARCH_AUTO_INDEX", true),
+		},
+		Scoring: ScoringConfig{
+			CalibrationMidpoint:  getEnvAsFloat("SCORE_CALIBRATION_MIDPOINT", 0.45),
+			CalibrationSteepness: getEnvAsFloat("SCORE_CALIBRATION_STEEPNESS", 15),
+		},
+		Dedup: DedupConfig{
+			Policy:    getEnv("DEDUP_POLICY", "warn"),
+			Threshold: getEnvAsFloat("DEDUP_THRESHOLD", 0.95),
+		},
+		Rerank: RerankConfig{
+			Provider: getEnv("RERANK_PROVIDER", "none"),
+			BaseURL:  getEnv("RERANK_BASE_URL", "https://api.cohere.com/v2"),
+			APIKey:   getEnv("RERANK_API_KEY", ""),
+			Model:    getEnv("RERANK_MODEL", "rerank-v3.5"),
+			Timeout:  getEnvAsDuration("RERANK_TIMEOUT", 10*time.Second),
+		},
 	}
 
+	config.Embedding = loadEmbeddingConfig("EMBEDDING_", EmbeddingConfig{
+		Provider:        "mock",
+		BaseURL:         "https://api.openai.com/v1",
+		Model:           "text-embedding-3-small",
+		Dimensions:      1536,
+		MaxBatchSize:    100,
+		Timeout:         30 * time.Second,
+		BatchWindow:     5 * time.Millisecond,
+		CacheSize:       10000,
+		CachePersistent: true,
+	})
+
+	// Secondary providers inherit primary settings except the provider itself and its credentials
+	fallbackDefaults := config.Embedding
+	fallbackDefaults.Provider = ""
+	fallbackDefaults.APIKey = ""
+	config.EmbeddingFallback = loadEmbeddingConfig("EMBEDDING_FALLBACK_", fallbackDefaults)
+	config.EmbeddingShadow = loadEmbeddingConfig("EMBEDDING_SHADOW_", fallbackDefaults)
+
 	return config, nil
 }
 
+// loadEmbeddingConfig loads embedding provider configuration from variables with the given prefix
+func loadEmbeddingConfig(prefix string, defaults EmbeddingConfig) EmbeddingConfig {
+	return EmbeddingConfig{
+		Provider:      getEnv(prefix+"PROVIDER", defaults.Provider),
+		BaseURL:       getEnv(prefix+"BASE_URL", defaults.BaseURL),
+		APIKey:        getEnv(prefix+"API_KEY", defaults.APIKey),
+		Model:         getEnv(prefix+"MODEL", defaults.Model),
+		Dimensions:    getEnvAsInt(prefix+"DIMENSIONS", defaults.Dimensions),
+		MaxBatchSize:  getEnvAsInt(prefix+"MAX_BATCH_SIZE", defaults.MaxBatchSize),
+		MaxBatchChars: getEnvAsInt(prefix+"MAX_BATCH_CHARS", defaults.MaxBatchChars),
+		Timeout:       getEnvAsDuration(prefix+"TIMEOUT", defaults.Timeout),
+
+		RequestDimensions: defaults.RequestDimensions || os.Getenv(prefix+"DIMENSIONS") != "",
+
+		BatchWindow: getEnvAsDuration(prefix+"BATCH_WINDOW", defaults.BatchWindow),
+
+		CacheSize:       getEnvAsInt(prefix+"CACHE_SIZE", defaults.CacheSize),
+		CachePersistent: getEnvAsBool(prefix+"CACHE_PERSISTENT", defaults.CachePersistent),
+	}
+}
+
 // GetDSN returns the database connection string
 func (d *DatabaseConfig) GetDSN() string {
 	return fmt.Sprintf(
@@ -84,3 +234,30 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DedupPolicy defines how near-duplicates of a created or updated rule are handled
+type DedupPolicy string
+
+const (
+	// DedupReject fails the request with a DuplicateRulesError
+	DedupReject DedupPolicy = "reject"
+	// DedupWarn saves the rule and lists the duplicates in the response
+	DedupWarn DedupPolicy = "warn"
+	// DedupAllow saves the rule without looking for duplicates
+	DedupAllow DedupPolicy = "allow"
+)
+
+// IsValid reports whether the policy is known
+func (p DedupPolicy) IsValid() bool {
+	switch p {
+	case DedupReject, DedupWarn, DedupAllow:
+		return true
+	default:
+		return false
+	}
+}
+
+// DuplicateRulesError is returned when a rule is rejected as a near-duplicate of existing rules
+type DuplicateRulesError struct {
+	Duplicates []*RuleMatch
+}
+
+func (e *DuplicateRulesError) Error() string {
+	ids := make([]string, len(e.Duplicates))
+	for i, duplicate := range e.Duplicates {
+		ids[i] = fmt.Sprintf("%d", duplicate.ID)
+	}
+	return fmt.Sprintf("rule duplicates existing rules %s", strings.Join(ids, ", "))
+}
+
+// Unwrap makes the error match ErrDuplicateEntry
+func (e *DuplicateRulesError) Unwrap() error {
+	return ErrDuplicateEntry
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RuleTypeFilter selects rules by their type. Rules must have one of the included types,
+// if any, and none of the excluded ones.
+type RuleTypeFilter struct {
+	Include    []string `json:"include,omitempty"` // Type names
+	IncludeIDs []int64  `json:"include_ids,omitempty"`
+	Exclude    []string `json:"exclude,omitempty"`
+	ExcludeIDs []int64  `json:"exclude_ids,omitempty"`
+}
+
+// IsEmpty reports whether the filter selects rules of every type
+func (f *RuleTypeFilter) IsEmpty() bool {
+	return f == nil || len(f.Include)+len(f.IncludeIDs)+len(f.Exclude)+len(f.ExcludeIDs) == 0
+}
+
+// FilterOperator defines the condition of a metadata filter
+type FilterOperator string
+
+const (
+	// FilterEq matches rules whose value at Path equals Value
+	FilterEq FilterOperator = "eq"
+	// FilterNe matches rules whose value at Path differs from Value or is missing
+	FilterNe FilterOperator = "ne"
+	// FilterIn matches rules whose value at Path equals one of Values
+	FilterIn FilterOperator = "in"
+	// FilterRange matches rules whose value at Path is within the bounds; bounds and value must be of the same type
+	FilterRange FilterOperator = "range"
+	// FilterExists matches rules that have a value at Path
+	FilterExists FilterOperator = "exists"
+	// FilterContains matches rules whose array or object at Path contains Value
+	FilterContains FilterOperator = "contains"
+	// FilterAnd matches rules matching all Filters
+	FilterAnd FilterOperator = "and"
+	// FilterOr matches rules matching any of Filters
+	FilterOr FilterOperator = "or"
+)
+
+// MetadataFilter is a condition on rule content. Leaf conditions address a value with a
+// JSON pointer (RFC 6901); and/or combine nested filters.
+type MetadataFilter struct {
+	Op      FilterOperator    `json:"op"`
+	Path    string            `json:"path,omitempty"`   // JSON pointer into rule content, e.g. /limits/threshold
+	Value   json.RawMessage   `json:"value,omitempty"`  // eq, ne, contains
+	Values  []json.RawMessage `json:"values,omitempty"` // in
+	Gt      json.RawMessage   `json:"gt,omitempty"`     // range bounds
+	Gte     json.RawMessage   `json:"gte,omitempty"`
+	Lt      json.RawMessage   `json:"lt,omitempty"`
+	Lte     json.RawMessage   `json:"lte,omitempty"`
+	Filters []MetadataFilter  `json:"filters,omitempty"` // and, or
+}
+
+// PathSegments splits the JSON pointer of the filter into unescaped reference tokens
+func (f *MetadataFilter) PathSegments() ([]string, error) {
+	if !strings.HasPrefix(f.Path, "/") {
+		return nil, fmt.Errorf("%w: filter path '%s' must be a JSON pointer starting with '/'", ErrInvalidInput, f.Path)
+	}
+
+	segments := strings.Split(f.Path[1:], "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return segments, nil
+}
@@ -6,53 +6,94 @@ import (
 )
 
 var (
-	ErrRuleNotFound     = errors.New("rule not found")
-	ErrRuleTypeNotFound = errors.New("rule type not found")
-	ErrInvalidInput     = errors.New("invalid input")
-	ErrDuplicateEntry   = errors.New("duplicate entry")
+	ErrRuleNotFound       = errors.New("rule not found")
+	ErrRuleTypeNotFound   = errors.New("rule type not found")
+	ErrInvalidInput       = errors.New("invalid input")
+	ErrDuplicateEntry     = errors.New("duplicate entry")
+	ErrReindexJobNotFound = errors.New("reindex job not found")
+
+	// ErrReindexJobNotRunning is returned when a job is no longer running, e.g. canceled through the API
+	ErrReindexJobNotRunning = errors.New("reindex job is not running")
 )
 
 // RuleRepository defines the interface for rule data access
 type RuleRepository interface {
 	// Create creates a new rule
 	Create(ctx context.Context, rule *Rule) (*Rule, error)
-	
+
 	// GetByID retrieves a rule by ID
 	GetByID(ctx context.Context, id int64) (*Rule, error)
-	
+
 	// Update updates an existing rule
 	Update(ctx context.Context, rule *Rule) (*Rule, error)
-	
+
 	// Delete deletes a rule by ID
 	Delete(ctx context.Context, id int64) error
-	
-	// List retrieves rules with optional type filter
-	List(ctx context.Context, ruleType *string, limit, offset int) ([]*Rule, error)
-	
-	// FindSimilar finds rules similar to the given embedding
-	FindSimilar(ctx context.Context, embedding []float32, ruleType *string, limit int) ([]*RuleMatch, error)
-	
-	// UpdateEmbedding updates the embedding of a rule
-	UpdateEmbedding(ctx context.Context, id int64, embedding []float32) error
+
+	// List retrieves rules with optional type and content filters
+	List(ctx context.Context, types *RuleTypeFilter, filter *MetadataFilter, limit, offset int) ([]*Rule, error)
+
+	// FindSimilar finds rules similar to the given embedding among vectors of the same model,
+	// scoring every rule by its best matching chunk
+	FindSimilar(ctx context.Context, search *SimilaritySearch) ([]*RuleMatch, error)
+
+	// StreamSimilar runs FindSimilar, passing every match to emit as soon as it is read.
+	// An emit error stops the search and is returned.
+	StreamSimilar(ctx context.Context, search *SimilaritySearch, emit func(*RuleMatch) error) error
+
+	// ExplainSimilar returns the execution plan of the FindSimilar query, one line per element
+	ExplainSimilar(ctx context.Context, search *SimilaritySearch) ([]string, error)
+
+	// EnsureVectorIndex creates the vector index of chunks of the model, size and metric if there is none.
+	// It reports whether an index was created.
+	EnsureVectorIndex(ctx context.Context, model string, dimensions int, metric DistanceMetric) (bool, error)
+
+	// UpdateEmbedding creates or replaces the embedding and chunks of a rule for the embedding model
+	UpdateEmbedding(ctx context.Context, id int64, embedding *RuleEmbedding) error
+
+	// ListForReindex retrieves rules matching the filter with ID greater than afterID, ordered by ID
+	ListForReindex(ctx context.Context, filter *ReindexFilter, afterID int64, limit int) ([]*Rule, error)
+
+	// CountForReindex counts rules matching the filter
+	CountForReindex(ctx context.Context, filter *ReindexFilter) (int, error)
+}
+
+// ReindexJobRepository defines the interface for reindex job persistence
+type ReindexJobRepository interface {
+	// Create creates a new reindex job
+	Create(ctx context.Context, job *ReindexJob) (*ReindexJob, error)
+
+	// GetByID retrieves a reindex job by ID
+	GetByID(ctx context.Context, id int64) (*ReindexJob, error)
+
+	// Update saves progress and status of a reindex job that is still running in storage,
+	// returning ErrReindexJobNotRunning otherwise
+	Update(ctx context.Context, job *ReindexJob) error
+
+	// List retrieves reindex jobs, newest first
+	List(ctx context.Context, limit, offset int) ([]*ReindexJob, error)
+
+	// ListByStatus retrieves reindex jobs with the given status
+	ListByStatus(ctx context.Context, status ReindexStatus) ([]*ReindexJob, error)
 }
 
 // RuleTypeRepository defines the interface for rule type data access
 type RuleTypeRepository interface {
 	// Create creates a new rule type
 	Create(ctx context.Context, ruleType *RuleType) (*RuleType, error)
-	
+
 	// GetByID retrieves a rule type by ID
 	GetByID(ctx context.Context, id int64) (*RuleType, error)
-	
+
 	// GetByName retrieves a rule type by name
 	GetByName(ctx context.Context, name string) (*RuleType, error)
-	
+
 	// Update updates an existing rule type
 	Update(ctx context.Context, ruleType *RuleType) (*RuleType, error)
-	
+
 	// Delete deletes a rule type by ID
 	Delete(ctx context.Context, id int64) error
-	
+
 	// List retrieves all rule types
 	List(ctx context.Context, limit, offset int) ([]*RuleType, error)
 }
@@ -61,46 +102,113 @@ type RuleTypeRepository interface {
 type EmbeddingProvider interface {
 	// GenerateEmbedding generates an embedding for the given text
 	GenerateEmbedding(ctx context.Context, text string) ([]float32, error)
-	
+
 	// GenerateBatchEmbeddings generates embeddings for multiple texts
 	GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error)
+
+	// Model returns the name of the model producing the embeddings
+	Model() string
+
+	// Dimensions returns the size of the produced embeddings
+	Dimensions() int
+}
+
+// Reranker scores retrieval candidates against the query, usually with a model more precise than vector similarity
+type Reranker interface {
+	// Rerank returns relevance scores of the documents to the query, in document order
+	Rerank(ctx context.Context, query string, documents []string) ([]float64, error)
+
+	// Name returns the name of the reranker or its model
+	Name() string
+}
+
+// EmbeddingCacheRepository defines the interface for persistent embedding cache storage
+type EmbeddingCacheRepository interface {
+	// GetMany retrieves cached embeddings of the given model keyed by content hash
+	GetMany(ctx context.Context, model string, hashes []string) (map[string][]float32, error)
+
+	// PutMany stores embeddings of the given model keyed by content hash
+	PutMany(ctx context.Context, model string, embeddings map[string][]float32) error
+}
+
+// ServingModelReporter is implemented by embedding providers that can answer with a model
+// other than Model(), such as failover chains
+type ServingModelReporter interface {
+	// GenerateBatchEmbeddingsWithModel generates embeddings and returns the name of the model that produced them
+	GenerateBatchEmbeddingsWithModel(ctx context.Context, texts []string) ([][]float32, string, error)
+}
+
+// EmbeddingStatsReporter is implemented by embedding providers exposing runtime statistics
+type EmbeddingStatsReporter interface {
+	// EmbeddingStats returns statistics keyed by component name
+	EmbeddingStats() map[string]interface{}
 }
 
 // RuleService defines business logic operations for rules
 type RuleService interface {
 	// RetrieveSimilar retrieves rules similar to the given queries
 	RetrieveSimilar(ctx context.Context, query *RetrieveRulesQuery) ([]*RuleMatch, error)
-	
+
+	// RetrieveStream retrieves rules similar to the given queries, passing matches to emit in rank order
+	RetrieveStream(ctx context.Context, query *RetrieveRulesQuery, emit func(*RuleMatch) error) (*RetrieveSummary, error)
+
+	// RetrieveBatch retrieves rules for independent query groups, returning a result per group in order
+	RetrieveBatch(ctx context.Context, queries []*RetrieveRulesQuery) ([]*BatchRetrieveResult, error)
+
+	// FindSimilarRules retrieves rules similar to an existing rule by its stored embedding, excluding the rule itself
+	FindSimilarRules(ctx context.Context, query *SimilarRulesQuery) ([]*RuleMatch, error)
+
 	// CreateRule creates a new rule
 	CreateRule(ctx context.Context, req *CreateRuleRequest) (*Rule, error)
-	
+
 	// GetRule retrieves a rule by ID
 	GetRule(ctx context.Context, id int64) (*Rule, error)
-	
+
 	// UpdateRule updates an existing rule
 	UpdateRule(ctx context.Context, req *UpdateRuleRequest) (*Rule, error)
-	
+
 	// DeleteRule deletes a rule by ID
 	DeleteRule(ctx context.Context, id int64) error
-	
+
 	// ListRules retrieves rules with optional filters
-	ListRules(ctx context.Context, ruleType *string, limit, offset int) ([]*Rule, error)
+	ListRules(ctx context.Context, types *RuleTypeFilter, filter *MetadataFilter, limit, offset int) ([]*Rule, error)
 }
 
 // RuleTypeService defines business logic operations for rule types
 type RuleTypeService interface {
 	// CreateRuleType creates a new rule type
 	CreateRuleType(ctx context.Context, req *CreateRuleTypeRequest) (*RuleType, error)
-	
+
 	// GetRuleType retrieves a rule type by ID
 	GetRuleType(ctx context.Context, id int64) (*RuleType, error)
-	
+
 	// UpdateRuleType updates an existing rule type
 	UpdateRuleType(ctx context.Context, req *UpdateRuleTypeRequest) (*RuleType, error)
-	
+
 	// DeleteRuleType deletes a rule type by ID
 	DeleteRuleType(ctx context.Context, id int64) error
-	
+
 	// ListRuleTypes retrieves all rule types
 	ListRuleTypes(ctx context.Context, limit, offset int) ([]*RuleType, error)
-}
\ No newline at end of file
+}
+
+// ReindexService defines operations for background re-embedding of rules
+type ReindexService interface {
+	// StartReindex starts a new reindex job in the background
+	StartReindex(ctx context.Context, req *StartReindexRequest) (*ReindexJob, error)
+
+	// GetReindexJob retrieves a reindex job by ID
+	GetReindexJob(ctx context.Context, id int64) (*ReindexJob, error)
+
+	// ListReindexJobs retrieves reindex jobs, newest first
+	ListReindexJobs(ctx context.Context, limit, offset int) ([]*ReindexJob, error)
+
+	// CancelReindexJob stops a running reindex job
+	CancelReindexJob(ctx context.Context, id int64) (*ReindexJob, error)
+
+	// ReindexRuleType starts a job per configured model re-embedding stale rules of the type
+	ReindexRuleType(ctx context.Context, ruleType string) ([]*ReindexJob, error)
+
+	// ResumeReindexJobs restarts jobs interrupted by a shutdown from their last position
+	ResumeReindexJobs(ctx context.Context) error
+}
@@ -7,58 +7,324 @@ import (
 
 // RuleType represents a category of rules
 type RuleType struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// Extraction of the text embedded for rules of this type. A template takes precedence
+	// over fields; without both, string values of the content are concatenated.
+	EmbeddingFields            []string  `json:"embedding_fields,omitempty"`   // JSON pointers (RFC 6901) into rule content
+	EmbeddingTemplate          *string   `json:"embedding_template,omitempty"` // Go text/template executed over rule content
+	EmbeddingTemplateUpdatedAt time.Time `json:"embedding_template_updated_at"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// Rule represents a business rule with vector embedding
+// Rule represents a business rule with vector embeddings
 type Rule struct {
 	ID         int64           `json:"id"`
 	RuleTypeID int64           `json:"rule_type_id"`
 	Content    json.RawMessage `json:"content"`
-	Embedding  []float32       `json:"-"` // Vector embedding for similarity search
+	Embeddings []RuleEmbedding `json:"embeddings,omitempty"` // Vector embeddings for similarity search, one per model
 	CreatedAt  time.Time       `json:"created_at"`
 	UpdatedAt  time.Time       `json:"updated_at"`
-	
+
 	// Populated from join
 	RuleTypeName *string `json:"rule_type_name,omitempty"`
+
+	// Populated on create and update with the warn dedup policy
+	PossibleDuplicates []*RuleMatch `json:"possible_duplicates,omitempty"`
+}
+
+// RuleEmbedding represents rule vectors produced by a specific embedding model
+type RuleEmbedding struct {
+	Model      string      `json:"model"`
+	Dimensions int         `json:"dimensions"`
+	Vector     []float32   `json:"-"`                // Pooled vector of all chunks
+	Chunks     []RuleChunk `json:"chunks,omitempty"` // Parts of the rule text embedded separately
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// RuleChunk represents a part of the rule text with its own vector
+type RuleChunk struct {
+	Index  int       `json:"index"`
+	Text   string    `json:"text"`
+	Vector []float32 `json:"-"`
+}
+
+// EmbeddingFor returns the rule vector produced by the given model, or nil if there is none
+func (r *Rule) EmbeddingFor(model string) []float32 {
+	for _, embedding := range r.Embeddings {
+		if embedding.Model == model {
+			return embedding.Vector
+		}
+	}
+	return nil
 }
 
 // RuleMatch represents a rule with similarity score
 type RuleMatch struct {
 	Rule
-	Score float64 `json:"score"`
+	Score float64    `json:"score"`
+	Model string     `json:"model"`           // Embedding model the score was computed with
+	Chunk *RuleChunk `json:"chunk,omitempty"` // Best matching chunk the score comes from
+
+	// Set when results are reranked; Score is then the rerank score
+	VectorScore *float64 `json:"vector_score,omitempty"` // Score of the retrieval stage
+	RerankScore *float64 `json:"rerank_score,omitempty"`
+
+	Explanation *MatchExplanation `json:"explanation,omitempty"` // Set for queries with Explain
+}
+
+// MatchExplanation describes how a match was scored and ranked
+type MatchExplanation struct {
+	// Vector similarity of the best chunk to every query, in query order
+	QuerySimilarities []float64      `json:"query_similarities"`
+	Distance          float64        `json:"distance"` // Raw distance of the best chunk to the closest searched vector
+	Metric            DistanceMetric `json:"metric"`
+
+	// 1-based ranks after the search and fusion, and after reranking, diversification and quotas
+	RetrievalRank int `json:"retrieval_rank"`
+	FinalRank     int `json:"final_rank"`
+
+	Filters *SearchFilters `json:"filters"`
+	Plan    []string       `json:"plan,omitempty"` // Execution plan of the search query, in debug mode only
+}
+
+// SearchFilters describes the restrictions applied to a search
+type SearchFilters struct {
+	Types      *RuleTypeFilter `json:"types,omitempty"`
+	Metadata   *MetadataFilter `json:"metadata,omitempty"`
+	MinScore   *float64        `json:"min_score,omitempty"` // Threshold on the raw score applied in SQL
+	TypeQuotas map[string]int  `json:"type_quotas,omitempty"`
+}
+
+// SimilaritySearch represents parameters of a vector similarity search
+type SimilaritySearch struct {
+	Embedding []float32
+	Model     string // Only vectors of this model are compared
+	Types     *RuleTypeFilter
+	Limit     int
+	Metric    DistanceMetric // Defaults to cosine
+
+	// Hybrid search combines vector similarity with full-text rank of Text
+	Mode  SearchMode
+	Text  string
+	Alpha float64 // Weight of vector similarity, 1 - Alpha is the weight of the text rank
+
+	MinScore *float64 // Matches with a lower raw score are not returned
+
+	Filter     *MetadataFilter // Condition on rule content
+	ExcludeIDs []int64         // Rules never returned
+
+	IncludeEmbeddings      bool // Load pooled rule vectors of the model into match embeddings
+	IncludeChunkEmbeddings bool // Load vectors of the best chunks into match chunks
 }
 
 // CreateRuleRequest represents request to create a rule
 type CreateRuleRequest struct {
-	Type    string          `json:"type" validate:"required"`
-	Content json.RawMessage `json:"content" validate:"required"`
+	Type        string          `json:"type" validate:"required"`
+	Content     json.RawMessage `json:"content" validate:"required"`
+	DedupPolicy DedupPolicy     `json:"dedup_policy,omitempty"` // Defaults to the configured policy
 }
 
 // UpdateRuleRequest represents request to update a rule
 type UpdateRuleRequest struct {
-	ID      int64           `json:"id" validate:"required"`
-	Type    string          `json:"type" validate:"required"`
-	Content json.RawMessage `json:"content" validate:"required"`
+	ID          int64           `json:"id" validate:"required"`
+	Type        string          `json:"type" validate:"required"`
+	Content     json.RawMessage `json:"content" validate:"required"`
+	DedupPolicy DedupPolicy     `json:"dedup_policy,omitempty"` // Defaults to the configured policy
 }
 
 // CreateRuleTypeRequest represents request to create a rule type
 type CreateRuleTypeRequest struct {
-	Name string `json:"name" validate:"required"`
+	Name              string   `json:"name" validate:"required"`
+	EmbeddingFields   []string `json:"embedding_fields,omitempty"`
+	EmbeddingTemplate *string  `json:"embedding_template,omitempty"`
 }
 
-// UpdateRuleTypeRequest represents request to update a rule type
+// UpdateRuleTypeRequest represents request to update a rule type.
+// Omitted embedding fields and template are kept, the Clear flags reset them.
 type UpdateRuleTypeRequest struct {
-	ID   int64  `json:"id" validate:"required"`
-	Name string `json:"name" validate:"required"`
+	ID                int64    `json:"id" validate:"required"`
+	Name              string   `json:"name" validate:"required"`
+	EmbeddingFields   []string `json:"embedding_fields,omitempty"`
+	EmbeddingTemplate *string  `json:"embedding_template,omitempty"`
+	Reindex           bool     `json:"reindex,omitempty"` // Re-embed rules of this type if the extraction changed
+
+	ClearEmbeddingFields   bool `json:"clear_embedding_fields,omitempty"`
+	ClearEmbeddingTemplate bool `json:"clear_embedding_template,omitempty"`
+}
+
+// FusionStrategy defines how results of several queries are combined
+type FusionStrategy string
+
+const (
+	// FusionAverage searches once with the average of all query embeddings
+	FusionAverage FusionStrategy = "average"
+	// FusionMax searches per query and scores every rule by its best similarity
+	FusionMax FusionStrategy = "max"
+	// FusionRRF searches per query and combines ranks with reciprocal rank fusion
+	FusionRRF FusionStrategy = "rrf"
+	// FusionSum searches per query and scores every rule by the sum of its similarities
+	FusionSum FusionStrategy = "sum"
+)
+
+// SearchMode defines how rules are matched against queries
+type SearchMode string
+
+const (
+	// SearchModeVector scores rules by vector similarity only
+	SearchModeVector SearchMode = "vector"
+	// SearchModeHybrid scores rules by a weighted sum of vector similarity and full-text rank
+	SearchModeHybrid SearchMode = "hybrid"
+	// SearchModeHybridRRF combines vector and full-text ranks with reciprocal rank fusion
+	SearchModeHybridRRF SearchMode = "hybrid_rrf"
+)
+
+// DistanceMetric defines how vectors are compared
+type DistanceMetric string
+
+const (
+	// DistanceCosine compares vectors by cosine distance
+	DistanceCosine DistanceMetric = "cosine"
+	// DistanceL2 compares vectors by Euclidean distance
+	DistanceL2 DistanceMetric = "l2"
+	// DistanceInnerProduct compares vectors by inner product
+	DistanceInnerProduct DistanceMetric = "inner_product"
+)
+
+// IsValid reports whether the metric is known
+func (m DistanceMetric) IsValid() bool {
+	switch m {
+	case DistanceCosine, DistanceL2, DistanceInnerProduct:
+		return true
+	default:
+		return false
+	}
 }
 
+// ScoreMode defines the scale of match scores
+type ScoreMode string
+
+const (
+	// ScoreModeRaw returns scores as computed by the search: vector similarity or the hybrid score
+	ScoreModeRaw ScoreMode = "raw"
+	// ScoreModeCalibrated maps scores to 0..1 with a logistic curve, so that 0.5 separates
+	// relevant rules from weakly related ones
+	ScoreModeCalibrated ScoreMode = "calibrated"
+)
+
 // RetrieveRulesQuery represents query parameters for rule retrieval
 type RetrieveRulesQuery struct {
-	N       int      `json:"n" validate:"required,min=1,max=100"`
-	Type    *string  `json:"type,omitempty"`
-	Queries []string `json:"queries" validate:"required,min=1"`
-}
\ No newline at end of file
+	N       int            `json:"n" validate:"required,min=1,max=100"`
+	Type    *string        `json:"type,omitempty"` // Single included type, kept for compatibility with Types
+	Queries []string       `json:"queries" validate:"required,min=1"`
+	Model   *string        `json:"model,omitempty"`  // Embedding model to search with, defaults to the primary model
+	Fusion  FusionStrategy `json:"fusion,omitempty"` // Defaults to average
+	Mode    SearchMode     `json:"mode,omitempty"`   // Defaults to vector
+	Alpha   *float64       `json:"alpha,omitempty"`  // Weight of vector similarity in hybrid modes, defaults to 0.5
+	Metric  DistanceMetric `json:"metric,omitempty"` // Defaults to the configured metric
+
+	MinScore  *float64  `json:"min_score,omitempty"`  // Matches scored lower are dropped; in the scale of ScoreMode
+	ScoreMode ScoreMode `json:"score_mode,omitempty"` // Defaults to raw
+
+	Filter *MetadataFilter `json:"filter,omitempty"` // Condition on rule content
+
+	// Rule types to search in and to skip, by name or ID
+	Types          []string `json:"types,omitempty"`
+	TypeIDs        []int64  `json:"type_ids,omitempty"`
+	ExcludeTypes   []string `json:"exclude_types,omitempty"`
+	ExcludeTypeIDs []int64  `json:"exclude_type_ids,omitempty"`
+
+	TypeQuotas map[string]int `json:"type_quotas,omitempty"` // Maximum number of returned rules per type name
+
+	// Diversifies results with maximal marginal relevance when set: 1 ranks by relevance only,
+	// lower values favor rules unlike those already selected
+	MMRLambda *float64 `json:"mmr_lambda,omitempty"`
+
+	Rerank bool `json:"rerank,omitempty"` // Reorder candidates with the configured reranker
+
+	Explain bool `json:"explain,omitempty"` // Describe scoring and ranking of every match
+}
+
+// BatchRetrieveResult represents the outcome of one query group of a batch retrieval
+type BatchRetrieveResult struct {
+	Matches []*RuleMatch
+	Err     error // Failure of this group only
+}
+
+// RetrieveSummary describes a completed streamed retrieval
+type RetrieveSummary struct {
+	Returned        int
+	TotalCandidates int // Ranked candidates the returned matches were selected from
+
+	EmbeddingTime time.Duration
+	SearchTime    time.Duration
+	TotalTime     time.Duration
+}
+
+// SimilarRulesQuery represents parameters of a search for rules similar to an existing rule
+type SimilarRulesQuery struct {
+	RuleID int64
+	N      int
+	Model  *string        // Embedding model to compare with, defaults to the primary model
+	Metric DistanceMetric // Defaults to the configured metric
+	Types  *RuleTypeFilter
+}
+
+// ReindexScope selects which rules a reindex job processes
+type ReindexScope string
+
+const (
+	// ReindexScopeAll re-embeds every rule
+	ReindexScopeAll ReindexScope = "all"
+	// ReindexScopeMissing re-embeds rules without an embedding of the model
+	ReindexScopeMissing ReindexScope = "missing"
+	// ReindexScopeStale re-embeds rules without an embedding or modified after it was created
+	ReindexScopeStale ReindexScope = "stale"
+)
+
+// ReindexStatus represents the state of a reindex job
+type ReindexStatus string
+
+const (
+	ReindexStatusRunning   ReindexStatus = "running"
+	ReindexStatusCompleted ReindexStatus = "completed"
+	ReindexStatusFailed    ReindexStatus = "failed"
+	ReindexStatusCanceled  ReindexStatus = "canceled"
+)
+
+// ReindexFilter selects rules for re-embedding
+type ReindexFilter struct {
+	Model    string
+	Scope    ReindexScope
+	RuleType *string
+}
+
+// ReindexJob represents a background job regenerating rule embeddings
+type ReindexJob struct {
+	ID                int64         `json:"id"`
+	Model             string        `json:"model"`
+	Scope             ReindexScope  `json:"scope"`
+	RuleType          *string       `json:"rule_type,omitempty"`
+	BatchSize         int           `json:"batch_size"`
+	MaxRulesPerSecond int           `json:"max_rules_per_second"`
+	Status            ReindexStatus `json:"status"`
+	Total             int           `json:"total"`        // Rules matching the filter when the job started
+	Processed         int           `json:"processed"`    // Rules re-embedded so far
+	LastRuleID        int64         `json:"last_rule_id"` // Resume cursor: rules are processed in ID order
+	Error             *string       `json:"error,omitempty"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+	FinishedAt        *time.Time    `json:"finished_at,omitempty"`
+}
+
+// StartReindexRequest represents request to start a reindex job
+type StartReindexRequest struct {
+	Model             *string      `json:"model,omitempty"` // Defaults to the primary model
+	Scope             ReindexScope `json:"scope"`           // Defaults to stale
+	RuleType          *string      `json:"rule_type,omitempty"`
+	BatchSize         int          `json:"batch_size,omitempty"`           // Defaults to 50
+	MaxRulesPerSecond int          `json:"max_rules_per_second,omitempty"` // 0 means unthrottled
+}
@@ -0,0 +1,286 @@
+package embeddings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// ErrBatcherStopped is returned when a request arrives after the batching provider was stopped
+var ErrBatcherStopped = errors.New("embedding batcher is stopped")
+
+// batchingEmbeddingProvider coalesces concurrent embedding requests into batch calls.
+// Requests arriving within the batching window (or until the batch is full) are sent
+// to the wrapped provider as a single GenerateBatchEmbeddings call and the results are
+// fanned back to the callers.
+type batchingEmbeddingProvider struct {
+	inner    domain.EmbeddingProvider
+	window   time.Duration
+	maxBatch int
+	requests chan *pendingEmbedding
+	done     <-chan struct{}
+
+	batches   atomic.Int64
+	coalesced atomic.Int64
+	canceled  atomic.Int64
+	splits    atomic.Int64
+}
+
+// pendingEmbedding is a single text waiting to be embedded in the next batch
+type pendingEmbedding struct {
+	ctx    context.Context
+	text   string
+	result chan embeddingResult
+}
+
+// embeddingResult is delivered to a waiting caller once its batch completes
+type embeddingResult struct {
+	embedding []float32
+	err       error
+}
+
+// NewBatchingEmbeddingProvider wraps provider with a micro-batching dispatcher.
+// The dispatcher runs until ctx is canceled.
+func NewBatchingEmbeddingProvider(
+	ctx context.Context,
+	provider domain.EmbeddingProvider,
+	window time.Duration,
+	maxBatch int,
+) domain.EmbeddingProvider {
+	if maxBatch <= 0 {
+		maxBatch = 100
+	}
+
+	b := &batchingEmbeddingProvider{
+		inner:    provider,
+		window:   window,
+		maxBatch: maxBatch,
+		requests: make(chan *pendingEmbedding),
+		done:     ctx.Done(),
+	}
+	go b.run()
+
+	return b
+}
+
+// GenerateEmbedding queues the text for the next batch and waits for its embedding
+func (b *batchingEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	pending := b.enqueue(ctx, text)
+	return b.wait(ctx, pending)
+}
+
+// GenerateBatchEmbeddings queues small batches text by text so that they can be merged
+// with concurrent requests; batches that fill a whole API call go straight to the provider
+func (b *batchingEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, fmt.Errorf("%w: texts slice cannot be empty", domain.ErrInvalidInput)
+	}
+	if len(texts) >= b.maxBatch {
+		return b.inner.GenerateBatchEmbeddings(ctx, texts)
+	}
+
+	pending := make([]*pendingEmbedding, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			return nil, fmt.Errorf("%w: text %d cannot be empty", domain.ErrInvalidInput, i)
+		}
+		pending[i] = b.enqueue(ctx, text)
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for i, p := range pending {
+		embedding, err := b.wait(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate embedding for text %d: %w", i, err)
+		}
+		embeddings[i] = embedding
+	}
+
+	return embeddings, nil
+}
+
+// Model returns the model name of the wrapped provider
+func (b *batchingEmbeddingProvider) Model() string {
+	return b.inner.Model()
+}
+
+// Dimensions returns the embedding size of the wrapped provider
+func (b *batchingEmbeddingProvider) Dimensions() int {
+	return b.inner.Dimensions()
+}
+
+// EmbeddingStats returns batching statistics merged with statistics of the wrapped provider
+func (b *batchingEmbeddingProvider) EmbeddingStats() map[string]interface{} {
+	batches, coalesced := b.batches.Load(), b.coalesced.Load()
+	avgBatchSize := 0.0
+	if batches > 0 {
+		avgBatchSize = float64(coalesced) / float64(batches)
+	}
+
+	stats := innerStats(b.inner)
+	stats["batcher:"+b.inner.Model()] = map[string]interface{}{
+		"batches":        batches,
+		"requests":       coalesced,
+		"canceled":       b.canceled.Load(),
+		"splits":         b.splits.Load(),
+		"avg_batch_size": avgBatchSize,
+		"window":         b.window.String(),
+		"max_batch":      b.maxBatch,
+	}
+	return stats
+}
+
+// enqueue hands the text to the dispatcher; failures are delivered through the result channel
+func (b *batchingEmbeddingProvider) enqueue(ctx context.Context, text string) *pendingEmbedding {
+	pending := &pendingEmbedding{
+		ctx:    ctx,
+		text:   text,
+		result: make(chan embeddingResult, 1),
+	}
+
+	select {
+	case b.requests <- pending:
+	case <-ctx.Done():
+		pending.result <- embeddingResult{err: ctx.Err()}
+	case <-b.done:
+		pending.result <- embeddingResult{err: ErrBatcherStopped}
+	}
+
+	return pending
+}
+
+// wait blocks until the pending request is resolved or the caller gives up
+func (b *batchingEmbeddingProvider) wait(ctx context.Context, pending *pendingEmbedding) ([]float32, error) {
+	select {
+	case res := <-pending.result:
+		return res.embedding, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run collects requests into batches until the dispatcher is stopped
+func (b *batchingEmbeddingProvider) run() {
+	for {
+		select {
+		case first := <-b.requests:
+			batch := b.collect(first)
+			go b.flush(batch)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// collect gathers requests arriving within the window after the first one, up to maxBatch
+func (b *batchingEmbeddingProvider) collect(first *pendingEmbedding) []*pendingEmbedding {
+	batch := []*pendingEmbedding{first}
+	if b.window <= 0 {
+		return batch
+	}
+
+	timer := time.NewTimer(b.window)
+	defer timer.Stop()
+
+	for len(batch) < b.maxBatch {
+		select {
+		case pending := <-b.requests:
+			batch = append(batch, pending)
+		case <-timer.C:
+			return batch
+		case <-b.done:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// flush embeds the live requests of a batch with one provider call (see embed) and delivers the results.
+// The provider call is canceled only when every caller in the batch has given up.
+func (b *batchingEmbeddingProvider) flush(batch []*pendingEmbedding) {
+	live := make([]*pendingEmbedding, 0, len(batch))
+	for _, pending := range batch {
+		if err := pending.ctx.Err(); err != nil {
+			b.canceled.Add(1)
+			pending.result <- embeddingResult{err: err}
+			continue
+		}
+		live = append(live, pending)
+	}
+	if len(live) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var remaining atomic.Int64
+	remaining.Store(int64(len(live)))
+	for _, pending := range live {
+		stop := context.AfterFunc(pending.ctx, func() {
+			if remaining.Add(-1) == 0 {
+				cancel()
+			}
+		})
+		defer stop()
+	}
+
+	// Identical texts within a batch are embedded once
+	positions := make(map[string][]*pendingEmbedding, len(live))
+	texts := make([]string, 0, len(live))
+	for _, pending := range live {
+		if _, ok := positions[pending.text]; !ok {
+			texts = append(texts, pending.text)
+		}
+		positions[pending.text] = append(positions[pending.text], pending)
+	}
+
+	b.batches.Add(1)
+	b.coalesced.Add(int64(len(live)))
+
+	embeddings := make([][]float32, len(texts))
+	errs := make([]error, len(texts))
+	b.embed(ctx, texts, embeddings, errs)
+	for i, text := range texts {
+		for _, pending := range positions[text] {
+			if errs[i] != nil {
+				pending.result <- embeddingResult{err: errs[i]}
+				continue
+			}
+			// Every caller gets its own copy of a shared embedding
+			pending.result <- embeddingResult{embedding: append([]float32(nil), embeddings[i]...)}
+		}
+	}
+}
+
+// embed fills embeddings or errs for texts with one provider call. When the provider rejects
+// the input itself, the texts are split in halves and embedded again, so that the failure
+// stays with the callers whose texts caused it instead of failing the whole batch.
+func (b *batchingEmbeddingProvider) embed(ctx context.Context, texts []string, embeddings [][]float32, errs []error) {
+	result, err := b.inner.GenerateBatchEmbeddings(ctx, texts)
+	if err == nil {
+		copy(embeddings, result)
+		return
+	}
+
+	if len(texts) == 1 || ctx.Err() != nil || !isInputError(err) {
+		for i := range errs {
+			errs[i] = err
+		}
+		return
+	}
+
+	b.splits.Add(1)
+	mid := len(texts) / 2
+	b.embed(ctx, texts[:mid], embeddings[:mid], errs[:mid])
+	b.embed(ctx, texts[mid:], embeddings[mid:], errs[mid:])
+}
@@ -0,0 +1,93 @@
+package embeddings
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rejectingProvider fails every batch containing the rejected text, like an API answering 400
+type rejectingProvider struct {
+	*mockEmbeddingProvider
+	rejected string
+	calls    atomic.Int64
+}
+
+func (p *rejectingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	p.calls.Add(1)
+	for _, text := range texts {
+		if text == p.rejected {
+			return nil, &APIError{StatusCode: http.StatusBadRequest, Message: "invalid input"}
+		}
+	}
+	return p.mockEmbeddingProvider.GenerateBatchEmbeddings(ctx, texts)
+}
+
+// failingProvider fails every batch with the same error
+type failingProvider struct {
+	*mockEmbeddingProvider
+	err   error
+	calls atomic.Int64
+}
+
+func (p *failingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	p.calls.Add(1)
+	return nil, p.err
+}
+
+func TestBatcherIsolatesRejectedInput(t *testing.T) {
+	inner := &rejectingProvider{
+		mockEmbeddingProvider: NewMockEmbeddingProvider(8).(*mockEmbeddingProvider),
+		rejected:              "bad",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	provider := NewBatchingEmbeddingProvider(ctx, inner, 50*time.Millisecond, 100)
+
+	texts := []string{"one", "two", "bad", "three", "four"}
+	errs := make([]error, len(texts))
+
+	var wg sync.WaitGroup
+	for i, text := range texts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = provider.GenerateEmbedding(context.Background(), text)
+		}()
+	}
+	wg.Wait()
+
+	for i, text := range texts {
+		var apiErr *APIError
+		rejected := errors.As(errs[i], &apiErr)
+		if rejected != (text == inner.rejected) {
+			t.Errorf("GenerateEmbedding(%q) error = %v", text, errs[i])
+		}
+	}
+	if inner.calls.Load() < 2 {
+		t.Errorf("provider called %d times, want the rejected batch to be split", inner.calls.Load())
+	}
+}
+
+func TestBatcherDoesNotSplitOnTransientErrors(t *testing.T) {
+	inner := &failingProvider{
+		mockEmbeddingProvider: NewMockEmbeddingProvider(8).(*mockEmbeddingProvider),
+		err:                   &APIError{StatusCode: http.StatusServiceUnavailable},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	provider := NewBatchingEmbeddingProvider(ctx, inner, 50*time.Millisecond, 100)
+
+	if _, err := provider.GenerateBatchEmbeddings(context.Background(), []string{"one", "two", "three"}); err == nil {
+		t.Fatal("GenerateBatchEmbeddings() error = nil, want provider error")
+	}
+	if inner.calls.Load() != 1 {
+		t.Errorf("provider called %d times, want 1", inner.calls.Load())
+	}
+}
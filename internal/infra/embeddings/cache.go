@@ -0,0 +1,257 @@
+package embeddings
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// cachedEmbeddingProvider is a caching decorator around an EmbeddingProvider.
+// Lookups go through an in-memory LRU tier first, then through an optional
+// persistent tier keyed by sha256(text) and model name. Only remaining misses
+// reach the wrapped provider.
+type cachedEmbeddingProvider struct {
+	inner domain.EmbeddingProvider
+	store domain.EmbeddingCacheRepository
+
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+
+	memoryHits  atomic.Int64
+	storeHits   atomic.Int64
+	misses      atomic.Int64
+	storeErrors atomic.Int64
+}
+
+// lruEntry is an element of the in-memory LRU tier
+type lruEntry struct {
+	hash      string
+	embedding []float32
+}
+
+// NewCachedEmbeddingProvider wraps provider with an LRU of the given capacity and an optional persistent store.
+// A capacity of 0 disables the memory tier, a nil store disables the persistent tier.
+func NewCachedEmbeddingProvider(
+	provider domain.EmbeddingProvider,
+	store domain.EmbeddingCacheRepository,
+	capacity int,
+) domain.EmbeddingProvider {
+	return &cachedEmbeddingProvider{
+		inner:    provider,
+		store:    store,
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// GenerateEmbedding returns a cached embedding or generates a new one
+func (c *cachedEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	embeddings, err := c.GenerateBatchEmbeddings(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+
+	return embeddings[0], nil
+}
+
+// GenerateBatchEmbeddings returns cached embeddings and generates the missing ones in a single batch
+func (c *cachedEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, fmt.Errorf("%w: texts slice cannot be empty", domain.ErrInvalidInput)
+	}
+
+	result := make([][]float32, len(texts))
+	hashes := make([]string, len(texts))
+	missing := make(map[string][]int) // hash -> positions in texts
+
+	for i, text := range texts {
+		hashes[i] = ContentHash(text)
+		if embedding, ok := c.getMemory(hashes[i]); ok {
+			c.memoryHits.Add(1)
+			result[i] = embedding
+			continue
+		}
+		missing[hashes[i]] = append(missing[hashes[i]], i)
+	}
+
+	if len(missing) > 0 && c.store != nil {
+		c.loadFromStore(ctx, missing, result)
+	}
+
+	if len(missing) > 0 {
+		if err := c.generate(ctx, texts, missing, result); err != nil {
+			return nil, err
+		}
+	}
+
+	// Hand out copies so that callers cannot corrupt cached vectors
+	for i, embedding := range result {
+		result[i] = append([]float32(nil), embedding...)
+	}
+
+	return result, nil
+}
+
+// Model returns the model name of the wrapped provider
+func (c *cachedEmbeddingProvider) Model() string {
+	return c.inner.Model()
+}
+
+// Dimensions returns the embedding size of the wrapped provider
+func (c *cachedEmbeddingProvider) Dimensions() int {
+	return c.inner.Dimensions()
+}
+
+// EmbeddingStats returns cache hit/miss statistics merged with statistics of the wrapped provider
+func (c *cachedEmbeddingProvider) EmbeddingStats() map[string]interface{} {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+
+	memoryHits, storeHits, misses := c.memoryHits.Load(), c.storeHits.Load(), c.misses.Load()
+	hitRatio := 0.0
+	if total := memoryHits + storeHits + misses; total > 0 {
+		hitRatio = float64(memoryHits+storeHits) / float64(total)
+	}
+
+	stats := innerStats(c.inner)
+	stats["cache:"+c.inner.Model()] = map[string]interface{}{
+		"memory_hits":   memoryHits,
+		"store_hits":    storeHits,
+		"misses":        misses,
+		"store_errors":  c.storeErrors.Load(),
+		"hit_ratio":     hitRatio,
+		"memory_size":   size,
+		"memory_limit":  c.capacity,
+		"store_enabled": c.store != nil,
+	}
+	return stats
+}
+
+// loadFromStore fills result with embeddings found in the persistent tier and removes them from missing
+func (c *cachedEmbeddingProvider) loadFromStore(ctx context.Context, missing map[string][]int, result [][]float32) {
+	hashes := make([]string, 0, len(missing))
+	for hash := range missing {
+		hashes = append(hashes, hash)
+	}
+
+	found, err := c.store.GetMany(ctx, c.inner.Model(), hashes)
+	if err != nil {
+		// The cache must never break embedding generation
+		c.storeErrors.Add(1)
+		log.Printf("Embedding cache lookup failed: %v", err)
+		return
+	}
+
+	for hash, embedding := range found {
+		if len(embedding) != c.inner.Dimensions() {
+			continue
+		}
+		for _, i := range missing[hash] {
+			result[i] = embedding
+		}
+		c.storeHits.Add(int64(len(missing[hash])))
+		c.putMemory(hash, embedding)
+		delete(missing, hash)
+	}
+}
+
+// generate calls the wrapped provider for missing texts, deduplicating identical ones, and stores the results
+func (c *cachedEmbeddingProvider) generate(ctx context.Context, texts []string, missing map[string][]int, result [][]float32) error {
+	hashes := make([]string, 0, len(missing))
+	batch := make([]string, 0, len(missing))
+	for hash, positions := range missing {
+		hashes = append(hashes, hash)
+		batch = append(batch, texts[positions[0]])
+		c.misses.Add(int64(len(positions)))
+	}
+
+	embeddings, err := c.inner.GenerateBatchEmbeddings(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	generated := make(map[string][]float32, len(hashes))
+	for i, hash := range hashes {
+		for _, pos := range missing[hash] {
+			result[pos] = embeddings[i]
+		}
+		generated[hash] = embeddings[i]
+		c.putMemory(hash, embeddings[i])
+	}
+
+	if c.store != nil {
+		if err := c.store.PutMany(ctx, c.inner.Model(), generated); err != nil {
+			c.storeErrors.Add(1)
+			log.Printf("Embedding cache store failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// getMemory looks up the memory tier and marks the entry as recently used
+func (c *cachedEmbeddingProvider) getMemory(hash string) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).embedding, true
+}
+
+// putMemory adds an entry to the memory tier, evicting the least recently used one when full
+func (c *cachedEmbeddingProvider) putMemory(hash string, embedding []float32) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[hash]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[hash] = c.order.PushFront(&lruEntry{hash: hash, embedding: embedding})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).hash)
+	}
+}
+
+// ContentHash returns the hex-encoded sha256 of the text used as embedding cache key
+func ContentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// innerStats returns a copy of the statistics of a wrapped provider, if it reports any
+func innerStats(provider domain.EmbeddingProvider) map[string]interface{} {
+	stats := make(map[string]interface{})
+	if reporter, ok := provider.(domain.EmbeddingStatsReporter); ok {
+		for key, value := range reporter.EmbeddingStats() {
+			stats[key] = value
+		}
+	}
+	return stats
+}
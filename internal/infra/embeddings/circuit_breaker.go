@@ -0,0 +1,138 @@
+package embeddings
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// BreakerState is the state of a circuit breaker
+type BreakerState string
+
+const (
+	// BreakerClosed lets all calls through
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen rejects all calls until the cooldown expires
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a single probe call through
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// circuitBreaker tracks consecutive failures of a provider and stops calling it while it is unhealthy
+type circuitBreaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu                  sync.Mutex
+	state               BreakerState
+	consecutiveFailures int
+	totalFailures       int64
+	totalSuccesses      int64
+	rejected            int64
+	openedAt            time.Time
+	probeInFlight       bool
+	lastError           string
+}
+
+func newCircuitBreaker(name string, threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		threshold = 5
+	}
+	return &circuitBreaker{
+		name:      name,
+		threshold: threshold,
+		cooldown:  cooldown,
+		state:     BreakerClosed,
+	}
+}
+
+// Allow reports whether a call may be made now
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			b.rejected++
+			return false
+		}
+		b.transition(BreakerHalfOpen)
+		b.probeInFlight = true
+		return true
+	case BreakerHalfOpen:
+		if b.probeInFlight {
+			b.rejected++
+			return false
+		}
+		b.probeInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Success records a successful call
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.totalSuccesses++
+	b.consecutiveFailures = 0
+	b.probeInFlight = false
+	if b.state != BreakerClosed {
+		b.transition(BreakerClosed)
+	}
+}
+
+// Failure records a failed call and opens the circuit when the threshold is reached
+func (b *circuitBreaker) Failure(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.totalFailures++
+	b.consecutiveFailures++
+	b.probeInFlight = false
+	b.lastError = err.Error()
+
+	if b.state == BreakerHalfOpen || b.consecutiveFailures >= b.threshold {
+		b.openedAt = time.Now()
+		if b.state != BreakerOpen {
+			b.transition(BreakerOpen)
+		}
+	}
+}
+
+// Release frees the half-open probe slot when a call ended without a verdict (e.g. caller cancellation)
+func (b *circuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeInFlight = false
+}
+
+// Stats returns a snapshot of the breaker state
+func (b *circuitBreaker) Stats() map[string]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := map[string]interface{}{
+		"state":                b.state,
+		"consecutive_failures": b.consecutiveFailures,
+		"total_failures":       b.totalFailures,
+		"total_successes":      b.totalSuccesses,
+		"rejected":             b.rejected,
+		"last_error":           b.lastError,
+	}
+	if b.state != BreakerClosed {
+		stats["opened_at"] = b.openedAt
+	}
+	return stats
+}
+
+// transition changes the state and logs it; must be called with mu held
+func (b *circuitBreaker) transition(state BreakerState) {
+	log.Printf("Embedding provider %s circuit breaker: %s -> %s", b.name, b.state, state)
+	b.state = state
+}
@@ -0,0 +1,239 @@
+package embeddings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// ErrAllProvidersUnavailable is returned when every provider in the chain has an open circuit
+var ErrAllProvidersUnavailable = errors.New("all embedding providers are unavailable")
+
+// FailoverOptions configures retries and circuit breakers of the failover provider
+type FailoverOptions struct {
+	MaxRetries       int
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// failoverEmbeddingProvider calls providers in order, retrying transient errors with
+// exponential backoff and skipping providers whose circuit breaker is open
+type failoverEmbeddingProvider struct {
+	providers []domain.EmbeddingProvider
+	breakers  []*circuitBreaker
+	opts      FailoverOptions
+
+	retries   atomic.Int64
+	fallbacks atomic.Int64
+}
+
+// NewFailoverEmbeddingProvider creates a resilient provider over an ordered list of providers.
+// All providers must produce embeddings of the same dimensionality. Model reports the primary model,
+// GenerateBatchEmbeddingsWithModel the model of the provider that answered.
+func NewFailoverEmbeddingProvider(providers []domain.EmbeddingProvider, opts FailoverOptions) (domain.EmbeddingProvider, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one embedding provider is required")
+	}
+
+	breakers := make([]*circuitBreaker, len(providers))
+	for i, provider := range providers {
+		if provider.Dimensions() != providers[0].Dimensions() {
+			return nil, fmt.Errorf("embedding provider %s has %d dimensions, expected %d",
+				provider.Model(), provider.Dimensions(), providers[0].Dimensions())
+		}
+		breakers[i] = newCircuitBreaker(provider.Model(), opts.BreakerThreshold, opts.BreakerCooldown)
+	}
+
+	return &failoverEmbeddingProvider{
+		providers: providers,
+		breakers:  breakers,
+		opts:      opts,
+	}, nil
+}
+
+// GenerateEmbedding generates an embedding using the first healthy provider
+func (f *failoverEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	var embedding []float32
+	_, err := f.call(ctx, func(provider domain.EmbeddingProvider) error {
+		var err error
+		embedding, err = provider.GenerateEmbedding(ctx, text)
+		return err
+	})
+	return embedding, err
+}
+
+// GenerateBatchEmbeddings generates embeddings using the first healthy provider
+func (f *failoverEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	embeddings, _, err := f.GenerateBatchEmbeddingsWithModel(ctx, texts)
+	return embeddings, err
+}
+
+// GenerateBatchEmbeddingsWithModel generates embeddings using the first healthy provider
+// and returns its model name, which differs from Model when a fallback answered
+func (f *failoverEmbeddingProvider) GenerateBatchEmbeddingsWithModel(ctx context.Context, texts []string) ([][]float32, string, error) {
+	var embeddings [][]float32
+	served, err := f.call(ctx, func(provider domain.EmbeddingProvider) error {
+		var err error
+		embeddings, err = provider.GenerateBatchEmbeddings(ctx, texts)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return embeddings, served.Model(), nil
+}
+
+// Model returns the model name of the primary provider
+func (f *failoverEmbeddingProvider) Model() string {
+	return f.providers[0].Model()
+}
+
+// Dimensions returns the embedding size shared by all providers
+func (f *failoverEmbeddingProvider) Dimensions() int {
+	return f.providers[0].Dimensions()
+}
+
+// EmbeddingStats returns breaker state of every provider merged with their own statistics
+func (f *failoverEmbeddingProvider) EmbeddingStats() map[string]interface{} {
+	stats := make(map[string]interface{})
+	providers := make([]map[string]interface{}, len(f.providers))
+
+	for i, provider := range f.providers {
+		for key, value := range innerStats(provider) {
+			stats[key] = value
+		}
+		providers[i] = f.breakers[i].Stats()
+		providers[i]["model"] = provider.Model()
+		providers[i]["priority"] = i
+	}
+
+	stats["failover"] = map[string]interface{}{
+		"providers": providers,
+		"retries":   f.retries.Load(),
+		"fallbacks": f.fallbacks.Load(),
+	}
+	return stats
+}
+
+// call runs fn against providers in order until one succeeds and returns that provider
+func (f *failoverEmbeddingProvider) call(ctx context.Context, fn func(domain.EmbeddingProvider) error) (domain.EmbeddingProvider, error) {
+	var lastErr error
+
+	for i, provider := range f.providers {
+		breaker := f.breakers[i]
+		if !breaker.Allow() {
+			continue
+		}
+
+		if lastErr != nil {
+			log.Printf("Falling back to embedding provider %s: %v", provider.Model(), lastErr)
+		}
+
+		err := f.callWithRetry(ctx, provider, fn)
+		if err == nil {
+			breaker.Success()
+			if i > 0 {
+				f.fallbacks.Add(1)
+			}
+			return provider, nil
+		}
+
+		if ctx.Err() != nil {
+			breaker.Release()
+			return nil, err
+		}
+		if isInputError(err) {
+			// The request itself is invalid, other providers would reject it as well
+			breaker.Release()
+			return nil, err
+		}
+
+		breaker.Failure(err)
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		return nil, ErrAllProvidersUnavailable
+	}
+	return nil, fmt.Errorf("all embedding providers failed: %w", lastErr)
+}
+
+// callWithRetry runs fn against a single provider, retrying transient errors with exponential backoff
+func (f *failoverEmbeddingProvider) callWithRetry(ctx context.Context, provider domain.EmbeddingProvider, fn func(domain.EmbeddingProvider) error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn(provider)
+		if err == nil || attempt >= f.opts.MaxRetries || ctx.Err() != nil || !IsTransient(err) {
+			return err
+		}
+
+		f.retries.Add(1)
+		select {
+		case <-time.After(f.backoff(attempt)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// backoff returns the delay before the given retry attempt: base * 2^attempt capped at max, with jitter
+func (f *failoverEmbeddingProvider) backoff(attempt int) time.Duration {
+	if f.opts.RetryBaseDelay <= 0 {
+		return 0
+	}
+
+	delay := f.opts.RetryBaseDelay << attempt
+	if delay <= 0 || (f.opts.RetryMaxDelay > 0 && delay > f.opts.RetryMaxDelay) {
+		delay = f.opts.RetryMaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// Equal jitter keeps at least half of the delay while spreading concurrent retries
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// IsTransient reports whether an embedding error is worth retrying:
+// rate limiting, server-side errors, timeouts and network failures
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusRequestTimeout ||
+			apiErr.StatusCode == http.StatusTooManyRequests ||
+			apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// isInputError reports whether the provider rejected the request content itself
+func isInputError(err error) bool {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusBadRequest ||
+			apiErr.StatusCode == http.StatusRequestEntityTooLarge ||
+			apiErr.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package embeddings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// namedProvider reports a custom model name for the wrapped provider
+type namedProvider struct {
+	domain.EmbeddingProvider
+	model string
+}
+
+func (p *namedProvider) Model() string {
+	return p.model
+}
+
+func TestFailoverReportsServingModel(t *testing.T) {
+	primary := &failingProvider{
+		mockEmbeddingProvider: NewMockEmbeddingProvider(8).(*mockEmbeddingProvider),
+		err:                   &APIError{StatusCode: http.StatusServiceUnavailable},
+	}
+
+	tests := []struct {
+		name      string
+		providers []domain.EmbeddingProvider
+		wantModel string
+	}{
+		{
+			name: "primary",
+			providers: []domain.EmbeddingProvider{
+				&namedProvider{NewMockEmbeddingProvider(8), "primary"},
+				&namedProvider{NewMockEmbeddingProvider(8), "fallback"},
+			},
+			wantModel: "primary",
+		},
+		{
+			name: "fallback",
+			providers: []domain.EmbeddingProvider{
+				&namedProvider{primary, "primary"},
+				&namedProvider{NewMockEmbeddingProvider(8), "fallback"},
+			},
+			wantModel: "fallback",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewFailoverEmbeddingProvider(tt.providers, FailoverOptions{BreakerThreshold: 5})
+			if err != nil {
+				t.Fatalf("NewFailoverEmbeddingProvider() error = %v", err)
+			}
+
+			reporter, ok := provider.(domain.ServingModelReporter)
+			if !ok {
+				t.Fatal("failover provider does not report the serving model")
+			}
+
+			embeddings, model, err := reporter.GenerateBatchEmbeddingsWithModel(context.Background(), []string{"text"})
+			if err != nil {
+				t.Fatalf("GenerateBatchEmbeddingsWithModel() error = %v", err)
+			}
+			if len(embeddings) != 1 || model != tt.wantModel {
+				t.Errorf("GenerateBatchEmbeddingsWithModel() = %d embeddings of %q, want 1 of %q", len(embeddings), model, tt.wantModel)
+			}
+			if provider.Model() != "primary" {
+				t.Errorf("Model() = %q, want the primary model", provider.Model())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	// tokenFeatureWeight is the weight of a whole stemmed token
+	tokenFeatureWeight = 1.0
+	// bigramFeatureWeight is the weight of two adjacent tokens, rewarding matching phrases
+	bigramFeatureWeight = 0.5
+	// trigramFeatureWeight is the weight of a character trigram, tolerating typos and unstemmed forms
+	trigramFeatureWeight = 0.25
+)
+
+// localEmbeddingProvider is an offline embedding provider based on feature hashing.
+// Texts are tokenized (Russian and English), turned into token, token bigram and
+// character trigram features with sublinear TF weighting, hashed into a fixed number
+// of signed buckets and L2-normalized. Cosine similarity between such vectors reflects
+// lexical overlap, which makes retrieval results meaningful without any external model.
+// Stop-word removal stands in for corpus IDF so that vectors never change between restarts.
+type localEmbeddingProvider struct {
+	dimensions int
+}
+
+// NewLocalEmbeddingProvider creates a deterministic bag-of-words embedding provider
+func NewLocalEmbeddingProvider(dimensions int) domain.EmbeddingProvider {
+	if dimensions <= 0 {
+		dimensions = 1536 // Default to OpenAI ada-002 dimensions
+	}
+	return &localEmbeddingProvider{
+		dimensions: dimensions,
+	}
+}
+
+// GenerateEmbedding generates a feature-hashed embedding for the given text
+func (p *localEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	features := extractFeatures(text)
+	if len(features) == 0 {
+		// Text without words (punctuation only): fall back to the text itself
+		features = map[string]int{"w:" + strings.TrimSpace(text): 1}
+	}
+
+	vector := make([]float64, p.dimensions)
+	for feature, count := range features {
+		index, sign := hashFeature(feature, p.dimensions)
+		vector[index] += sign * featureWeight(feature) * (1 + math.Log(float64(count)))
+	}
+
+	var norm float64
+	for _, val := range vector {
+		norm += val * val
+	}
+	norm = math.Sqrt(norm)
+
+	embedding := make([]float32, p.dimensions)
+	for i, val := range vector {
+		if norm > 0 {
+			embedding[i] = float32(val / norm)
+		}
+	}
+
+	return embedding, nil
+}
+
+// GenerateBatchEmbeddings generates embeddings for multiple texts
+func (p *localEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, fmt.Errorf("%w: texts slice cannot be empty", domain.ErrInvalidInput)
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for i, text := range texts {
+		embedding, err := p.GenerateEmbedding(ctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate embedding for text %d: %w", i, err)
+		}
+		embeddings[i] = embedding
+	}
+
+	return embeddings, nil
+}
+
+// Model returns the name of the local model, versioned by its feature set
+func (p *localEmbeddingProvider) Model() string {
+	return "local-bow-v1"
+}
+
+// Dimensions returns the size of the produced embeddings
+func (p *localEmbeddingProvider) Dimensions() int {
+	return p.dimensions
+}
+
+// extractFeatures returns occurrence counts of token, token bigram and character trigram features
+func extractFeatures(text string) map[string]int {
+	tokens := Tokenize(text)
+	features := make(map[string]int, len(tokens)*4)
+
+	for i, token := range tokens {
+		features["w:"+token]++
+
+		if i > 0 {
+			features["b:"+tokens[i-1]+" "+token]++
+		}
+
+		runes := []rune("^" + token + "$")
+		for j := 0; j+3 <= len(runes); j++ {
+			features["t:"+string(runes[j:j+3])]++
+		}
+	}
+
+	return features
+}
+
+// featureWeight returns the weight of a feature kind identified by its prefix
+func featureWeight(feature string) float64 {
+	switch {
+	case strings.HasPrefix(feature, "b:"):
+		return bigramFeatureWeight
+	case strings.HasPrefix(feature, "t:"):
+		return trigramFeatureWeight
+	default:
+		return tokenFeatureWeight
+	}
+}
+
+// hashFeature maps a feature to a bucket index and a sign using FNV-1a.
+// The sign bit reduces the bias introduced by hash collisions.
+func hashFeature(feature string, dimensions int) (int, float64) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+
+	sign := 1.0
+	if sum>>63 == 1 {
+		sign = -1.0
+	}
+
+	return int(sum % uint64(dimensions)), sign
+}
@@ -0,0 +1,105 @@
+package embeddings
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestLocalProviderRanksLexicallySimilarTexts(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		similar   string
+		unrelated string
+	}{
+		{
+			name:      "english",
+			query:     "validate email address format",
+			similar:   "Email addresses must be validated against the format",
+			unrelated: "Orders above the credit limit require manager approval",
+		},
+		{
+			name:      "russian word forms",
+			query:     "проверка формата электронной почты",
+			similar:   "Формат электронной почты проверяется при регистрации",
+			unrelated: "Заказы сверх кредитного лимита согласует менеджер",
+		},
+		{
+			name:      "typo",
+			query:     "pasword length",
+			similar:   "Password length must be at least 12 characters",
+			unrelated: "Invoices are issued on the first day of the month",
+		},
+	}
+
+	provider := NewLocalEmbeddingProvider(256)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			embeddings, err := provider.GenerateBatchEmbeddings(context.Background(), []string{tt.query, tt.similar, tt.unrelated})
+			if err != nil {
+				t.Fatalf("GenerateBatchEmbeddings() error = %v", err)
+			}
+
+			similar := CosineSimilarity(embeddings[0], embeddings[1])
+			unrelated := CosineSimilarity(embeddings[0], embeddings[2])
+			if similar <= unrelated {
+				t.Errorf("similarity to %q = %.3f, want above %.3f of %q", tt.similar, similar, unrelated, tt.unrelated)
+			}
+		})
+	}
+}
+
+func TestLocalProviderIsDeterministic(t *testing.T) {
+	text := "Field is required"
+
+	first, err := NewLocalEmbeddingProvider(128).GenerateEmbedding(context.Background(), text)
+	if err != nil {
+		t.Fatalf("GenerateEmbedding() error = %v", err)
+	}
+	second, err := NewLocalEmbeddingProvider(128).GenerateEmbedding(context.Background(), text)
+	if err != nil {
+		t.Fatalf("GenerateEmbedding() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Error("embeddings of the same text differ between providers")
+	}
+}
+
+func TestLocalProviderKeepsNegations(t *testing.T) {
+	pairs := [][2]string{
+		{"field is required", "field is not required"},
+		{"поле обязательно", "поле не обязательно"},
+	}
+
+	provider := NewLocalEmbeddingProvider(256)
+	for _, pair := range pairs {
+		embeddings, err := provider.GenerateBatchEmbeddings(context.Background(), pair[:])
+		if err != nil {
+			t.Fatalf("GenerateBatchEmbeddings() error = %v", err)
+		}
+
+		if reflect.DeepEqual(embeddings[0], embeddings[1]) {
+			t.Errorf("%q and %q embed identically", pair[0], pair[1])
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "The rules are validated", want: []string{"rul", "valid"}},
+		{text: "Правила проверяются", want: []string{"правил", "проверя"}},
+		{text: "Ёлка и елка", want: []string{"елк", "елк"}},
+		{text: "is not required", want: []string{"not", "requir"}},
+	}
+
+	for _, tt := range tests {
+		if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
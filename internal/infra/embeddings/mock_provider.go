@@ -31,7 +31,7 @@ func NewMockEmbeddingProvider(dimensions int) domain.EmbeddingProvider {
 // TODO: Replace with real embedding service (OpenAI API, Sentence Transformers, etc.)
 func (m *mockEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	if text == "" {
-		return nil, fmt.Errorf("text cannot be empty")
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
 	}
 
 	// Create a deterministic "embedding" based on text content
@@ -67,7 +67,7 @@ func (m *mockEmbeddingProvider) GenerateEmbedding(ctx context.Context, text stri
 // GenerateBatchEmbeddings generates embeddings for multiple texts
 func (m *mockEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
 	if len(texts) == 0 {
-		return nil, fmt.Errorf("texts slice cannot be empty")
+		return nil, fmt.Errorf("%w: texts slice cannot be empty", domain.ErrInvalidInput)
 	}
 
 	embeddings := make([][]float32, len(texts))
@@ -82,6 +82,16 @@ func (m *mockEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, tex
 	return embeddings, nil
 }
 
+// Model returns the name of the mock model
+func (m *mockEmbeddingProvider) Model() string {
+	return "mock"
+}
+
+// Dimensions returns the size of the produced embeddings
+func (m *mockEmbeddingProvider) Dimensions() int {
+	return m.dimensions
+}
+
 // AverageEmbeddings computes the average of multiple embeddings
 // This is a utility function for aggregating multiple query embeddings
 func AverageEmbeddings(embeddings [][]float32) ([]float32, error) {
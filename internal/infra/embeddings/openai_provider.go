@@ -0,0 +1,235 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// openAIEmbeddingProvider generates embeddings through an OpenAI-compatible /embeddings API
+type openAIEmbeddingProvider struct {
+	client        *http.Client
+	endpoint      string
+	apiKey        string
+	model         string
+	dimensions    int
+	maxBatchSize  int
+	maxBatchChars int
+
+	// requestDimensions sends dimensions with every request
+	requestDimensions bool
+}
+
+// openAIEmbeddingRequest is the request body of the /embeddings endpoint
+type openAIEmbeddingRequest struct {
+	Model          string   `json:"model"`
+	Input          []string `json:"input"`
+	Dimensions     int      `json:"dimensions,omitempty"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+// openAIEmbeddingResponse is the response body of the /embeddings endpoint
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Model string `json:"model"`
+}
+
+// openAIErrorResponse is the error body returned by OpenAI-compatible APIs
+type openAIErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+		Code    any    `json:"code"`
+	} `json:"error"`
+}
+
+// APIError is returned when the embedding API responds with a non-2xx status
+type APIError struct {
+	StatusCode int
+	Type       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("embedding API error (status %d, %s): %s", e.StatusCode, e.Type, e.Message)
+	}
+	return fmt.Sprintf("embedding API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// NewOpenAIEmbeddingProvider creates a provider for the OpenAI /v1/embeddings wire format.
+// Any compatible server (Azure OpenAI proxy, vLLM, Ollama, LocalAI, etc.) can be used via BaseURL.
+func NewOpenAIEmbeddingProvider(cfg *config.EmbeddingConfig) (domain.EmbeddingProvider, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("embedding base URL is required")
+	}
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("embedding model is required")
+	}
+	if cfg.Dimensions <= 0 {
+		return nil, fmt.Errorf("embedding dimensions must be positive")
+	}
+
+	maxBatchSize := cfg.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = 100
+	}
+
+	return &openAIEmbeddingProvider{
+		client:        &http.Client{Timeout: cfg.Timeout},
+		endpoint:      strings.TrimRight(cfg.BaseURL, "/") + "/embeddings",
+		apiKey:        cfg.APIKey,
+		model:         cfg.Model,
+		dimensions:    cfg.Dimensions,
+		maxBatchSize:  maxBatchSize,
+		maxBatchChars: cfg.MaxBatchChars,
+
+		requestDimensions: cfg.RequestDimensions,
+	}, nil
+}
+
+// GenerateEmbedding generates an embedding for a single text
+func (p *openAIEmbeddingProvider) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	embeddings, err := p.request(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+
+	return embeddings[0], nil
+}
+
+// GenerateBatchEmbeddings generates embeddings for multiple texts,
+// splitting them into several API requests according to the batching limits
+func (p *openAIEmbeddingProvider) GenerateBatchEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, fmt.Errorf("%w: texts slice cannot be empty", domain.ErrInvalidInput)
+	}
+
+	for i, text := range texts {
+		if text == "" {
+			return nil, fmt.Errorf("%w: text %d cannot be empty", domain.ErrInvalidInput, i)
+		}
+	}
+
+	result := make([][]float32, 0, len(texts))
+	for _, batch := range p.splitBatches(texts) {
+		embeddings, err := p.request(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, embeddings...)
+	}
+
+	return result, nil
+}
+
+// Model returns the configured model name
+func (p *openAIEmbeddingProvider) Model() string {
+	return p.model
+}
+
+// Dimensions returns the size of the produced embeddings
+func (p *openAIEmbeddingProvider) Dimensions() int {
+	return p.dimensions
+}
+
+// splitBatches splits texts into consecutive batches respecting size and character limits
+func (p *openAIEmbeddingProvider) splitBatches(texts []string) [][]string {
+	var batches [][]string
+	start, chars := 0, 0
+
+	for i, text := range texts {
+		size := i - start
+		overChars := p.maxBatchChars > 0 && size > 0 && chars+len(text) > p.maxBatchChars
+		if size == p.maxBatchSize || overChars {
+			batches = append(batches, texts[start:i])
+			start, chars = i, 0
+		}
+		chars += len(text)
+	}
+
+	return append(batches, texts[start:])
+}
+
+// request performs a single /embeddings API call and returns embeddings in input order
+func (p *openAIEmbeddingProvider) request(ctx context.Context, texts []string) ([][]float32, error) {
+	payload := openAIEmbeddingRequest{
+		Model:          p.model,
+		Input:          texts,
+		EncodingFormat: "float",
+	}
+	if p.requestDimensions {
+		payload.Dimensions = p.dimensions
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal embedding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedding request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call embedding API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedding response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(respBody))}
+		var errResp openAIErrorResponse
+		if err := json.Unmarshal(respBody, &errResp); err == nil && errResp.Error.Message != "" {
+			apiErr.Type = errResp.Error.Type
+			apiErr.Message = errResp.Error.Message
+		}
+		return nil, apiErr
+	}
+
+	var parsed openAIEmbeddingResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode embedding response: %w", err)
+	}
+
+	if len(parsed.Data) != len(texts) {
+		return nil, fmt.Errorf("embedding API returned %d embeddings for %d inputs", len(parsed.Data), len(texts))
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for _, item := range parsed.Data {
+		if item.Index < 0 || item.Index >= len(texts) || embeddings[item.Index] != nil {
+			return nil, fmt.Errorf("embedding API returned invalid index %d", item.Index)
+		}
+		if len(item.Embedding) != p.dimensions {
+			return nil, fmt.Errorf("embedding API returned %d dimensions, expected %d", len(item.Embedding), p.dimensions)
+		}
+		embeddings[item.Index] = item.Embedding
+	}
+
+	return embeddings, nil
+}
@@ -0,0 +1,281 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// embeddingServer is a local stand-in for an OpenAI-compatible /embeddings API
+type embeddingServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []map[string]any
+
+	// reverse returns data items in reverse input order
+	reverse bool
+}
+
+func newEmbeddingServer(t *testing.T, handler http.HandlerFunc) *embeddingServer {
+	t.Helper()
+
+	s := &embeddingServer{}
+	if handler == nil {
+		handler = s.embed
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body["authorization"] = r.Header.Get("Authorization")
+
+		s.mu.Lock()
+		s.requests = append(s.requests, body)
+		s.mu.Unlock()
+
+		handler(w, r)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// embed answers with embeddings whose first component is the length of the input text
+func (s *embeddingServer) embed(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	body := s.requests[len(s.requests)-1]
+	s.mu.Unlock()
+
+	type item struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	}
+
+	input := body["input"].([]any)
+	data := make([]item, len(input))
+	for i, text := range input {
+		data[i] = item{Index: i, Embedding: []float32{float32(len(text.(string))), 1, 0}}
+	}
+	if s.reverse {
+		for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{"data": data, "model": body["model"]})
+}
+
+func newTestOpenAIProvider(t *testing.T, url string, cfg config.EmbeddingConfig) domain.EmbeddingProvider {
+	t.Helper()
+
+	cfg.BaseURL = url + "/v1/"
+	cfg.Model = "test-model"
+	cfg.Dimensions = 3
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+
+	provider, err := NewOpenAIEmbeddingProvider(&cfg)
+	if err != nil {
+		t.Fatalf("NewOpenAIEmbeddingProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestOpenAIProviderGenerateEmbedding(t *testing.T) {
+	server := newEmbeddingServer(t, nil)
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{APIKey: "secret"})
+
+	embedding, err := provider.GenerateEmbedding(context.Background(), "hello")
+	if err != nil {
+		t.Fatalf("GenerateEmbedding() error = %v", err)
+	}
+	if len(embedding) != 3 || embedding[0] != 5 {
+		t.Errorf("GenerateEmbedding() = %v, want embedding of the 5 character text", embedding)
+	}
+
+	request := server.requests[0]
+	if request["model"] != "test-model" {
+		t.Errorf("request model = %v, want test-model", request["model"])
+	}
+	if request["authorization"] != "Bearer secret" {
+		t.Errorf("request authorization = %v, want Bearer secret", request["authorization"])
+	}
+	if _, ok := request["dimensions"]; ok {
+		t.Errorf("request dimensions = %v, want it omitted when not configured", request["dimensions"])
+	}
+}
+
+func TestOpenAIProviderRequestDimensions(t *testing.T) {
+	server := newEmbeddingServer(t, nil)
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{RequestDimensions: true})
+
+	if _, err := provider.GenerateEmbedding(context.Background(), "hello"); err != nil {
+		t.Fatalf("GenerateEmbedding() error = %v", err)
+	}
+	if dims := server.requests[0]["dimensions"]; dims != float64(3) {
+		t.Errorf("request dimensions = %v, want 3", dims)
+	}
+}
+
+func TestOpenAIProviderSplitsBatches(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxBatchSize  int
+		maxBatchChars int
+		texts         []string
+		wantBatches   []int
+	}{
+		{
+			name:         "by size",
+			maxBatchSize: 2,
+			texts:        []string{"a", "bb", "ccc", "dddd", "eeeee"},
+			wantBatches:  []int{2, 2, 1},
+		},
+		{
+			name:          "by characters",
+			maxBatchSize:  10,
+			maxBatchChars: 5,
+			texts:         []string{"aaa", "bb", "cccc", "dddddddd", "e"},
+			wantBatches:   []int{2, 1, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newEmbeddingServer(t, nil)
+			provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{
+				MaxBatchSize:  tt.maxBatchSize,
+				MaxBatchChars: tt.maxBatchChars,
+			})
+
+			embeddings, err := provider.GenerateBatchEmbeddings(context.Background(), tt.texts)
+			if err != nil {
+				t.Fatalf("GenerateBatchEmbeddings() error = %v", err)
+			}
+
+			if len(server.requests) != len(tt.wantBatches) {
+				t.Fatalf("made %d requests, want %d", len(server.requests), len(tt.wantBatches))
+			}
+			for i, want := range tt.wantBatches {
+				if got := len(server.requests[i]["input"].([]any)); got != want {
+					t.Errorf("request %d has %d inputs, want %d", i, got, want)
+				}
+			}
+
+			for i, text := range tt.texts {
+				if embeddings[i][0] != float32(len(text)) {
+					t.Errorf("embedding %d = %v, want embedding of %q", i, embeddings[i], text)
+				}
+			}
+		})
+	}
+}
+
+func TestOpenAIProviderReordersByIndex(t *testing.T) {
+	server := newEmbeddingServer(t, nil)
+	server.reverse = true
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{})
+
+	texts := []string{"a", "bb", "ccc"}
+	embeddings, err := provider.GenerateBatchEmbeddings(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("GenerateBatchEmbeddings() error = %v", err)
+	}
+
+	for i, text := range texts {
+		if embeddings[i][0] != float32(len(text)) {
+			t.Errorf("embedding %d = %v, want embedding of %q", i, embeddings[i], text)
+		}
+	}
+}
+
+func TestOpenAIProviderInvalidResponses(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing item", body: `{"data":[{"index":0,"embedding":[1,0,0]}]}`},
+		{name: "duplicate index", body: `{"data":[{"index":0,"embedding":[1,0,0]},{"index":0,"embedding":[1,0,0]}]}`},
+		{name: "out of range index", body: `{"data":[{"index":0,"embedding":[1,0,0]},{"index":2,"embedding":[1,0,0]}]}`},
+		{name: "wrong dimensions", body: `{"data":[{"index":0,"embedding":[1,0]},{"index":1,"embedding":[1,0]}]}`},
+		{name: "malformed", body: `{"data":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newEmbeddingServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			})
+			provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{})
+
+			if _, err := provider.GenerateBatchEmbeddings(context.Background(), []string{"a", "b"}); err == nil {
+				t.Error("GenerateBatchEmbeddings() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestOpenAIProviderAPIError(t *testing.T) {
+	server := newEmbeddingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"message":"input too long","type":"invalid_request_error"}}`))
+	})
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{})
+
+	_, err := provider.GenerateEmbedding(context.Background(), "hello")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GenerateEmbedding() error = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Type != "invalid_request_error" || apiErr.Message != "input too long" {
+		t.Errorf("APIError = %+v, want status 400 with the API error type and message", apiErr)
+	}
+}
+
+func TestOpenAIProviderTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := newEmbeddingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer close(release)
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{Timeout: 50 * time.Millisecond})
+
+	if _, err := provider.GenerateEmbedding(context.Background(), "hello"); err == nil {
+		t.Error("GenerateEmbedding() error = nil, want timeout error")
+	}
+}
+
+func TestOpenAIProviderRejectsEmptyText(t *testing.T) {
+	server := newEmbeddingServer(t, nil)
+	provider := newTestOpenAIProvider(t, server.URL, config.EmbeddingConfig{})
+
+	_, err := provider.GenerateBatchEmbeddings(context.Background(), []string{"a", ""})
+	if !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("GenerateBatchEmbeddings() error = %v, want ErrInvalidInput", err)
+	}
+	if len(server.requests) != 0 {
+		t.Errorf("made %d requests, want none", len(server.requests))
+	}
+}
@@ -0,0 +1,22 @@
+package embeddings
+
+import (
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// NewProvider creates the embedding provider selected by configuration
+func NewProvider(cfg *config.EmbeddingConfig) (domain.EmbeddingProvider, error) {
+	switch cfg.Provider {
+	case "", "mock":
+		return NewMockEmbeddingProvider(cfg.Dimensions), nil
+	case "local":
+		return NewLocalEmbeddingProvider(cfg.Dimensions), nil
+	case "openai":
+		return NewOpenAIEmbeddingProvider(cfg)
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q", cfg.Provider)
+	}
+}
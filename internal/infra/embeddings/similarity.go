@@ -0,0 +1,44 @@
+package embeddings
+
+import "math"
+
+// CosineSimilarity computes the cosine similarity of two embeddings of the same size.
+// It returns 0 if either embedding has zero length or norm.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// Normalize returns a copy of the embedding scaled to unit length.
+// A zero embedding is returned unchanged as it has no direction.
+func Normalize(embedding []float32) []float32 {
+	var norm float64
+	for _, val := range embedding {
+		norm += float64(val) * float64(val)
+	}
+
+	normalized := make([]float32, len(embedding))
+	if norm == 0 {
+		copy(normalized, embedding)
+		return normalized
+	}
+
+	norm = math.Sqrt(norm)
+	for i, val := range embedding {
+		normalized[i] = float32(float64(val) / norm)
+	}
+	return normalized
+}
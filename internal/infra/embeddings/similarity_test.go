@@ -0,0 +1,46 @@
+package embeddings
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		embedding []float32
+		want      []float32
+	}{
+		{name: "scaled", embedding: []float32{3, 4}, want: []float32{0.6, 0.8}},
+		{name: "unit", embedding: []float32{0, 1}, want: []float32{0, 1}},
+		{name: "zero", embedding: []float32{0, 0}, want: []float32{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.embedding); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize(%v) = %v, want %v", tt.embedding, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedAverageKeepsCosineScores(t *testing.T) {
+	// The average of unit vectors is shorter than one, so inner product and L2 based
+	// scores drift from cosine similarity until it is normalized again
+	average, err := AverageEmbeddings([][]float32{{1, 0}, {0, 1}})
+	if err != nil {
+		t.Fatalf("AverageEmbeddings() error = %v", err)
+	}
+	normalized := Normalize(average)
+	chunk := []float32{1, 0}
+
+	var dot float64
+	for i := range normalized {
+		dot += float64(normalized[i]) * float64(chunk[i])
+	}
+	if cosine := CosineSimilarity(average, chunk); math.Abs(dot-cosine) > 1e-6 {
+		t.Errorf("inner product = %.6f, want cosine similarity %.6f", dot, cosine)
+	}
+}
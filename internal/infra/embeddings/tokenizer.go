@@ -0,0 +1,102 @@
+package embeddings
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minStemLength is the minimum number of runes left after suffix stripping
+const minStemLength = 3
+
+// stopWords contains frequent Russian and English words that carry no lexical meaning.
+// Negations (not, не, нет, ни, без) are kept: they invert the meaning of a rule.
+var stopWords = makeSet(
+	// English
+	"a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "has", "have", "if", "in",
+	"into", "is", "it", "its", "of", "on", "or", "that", "the", "their", "then", "there", "these",
+	"this", "to", "was", "were", "when", "which", "will", "with", "should", "must", "can",
+	// Russian
+	"и", "в", "во", "что", "он", "на", "я", "с", "со", "как", "а", "то", "все", "она", "так",
+	"его", "но", "да", "ты", "к", "у", "же", "вы", "за", "бы", "по", "только", "ее", "мне", "было",
+	"вот", "от", "меня", "еще", "о", "из", "ему", "теперь", "когда", "даже", "ну", "ли",
+	"если", "уже", "или", "быть", "был", "него", "до", "вас", "нибудь", "опять", "уж", "вам",
+	"ведь", "там", "потом", "себя", "ничего", "ей", "может", "они", "тут", "где", "есть", "надо",
+	"ней", "для", "мы", "тебя", "их", "чем", "была", "сам", "чтоб", "будто", "чего", "раз",
+	"тоже", "себе", "под", "будет", "ж", "тогда", "кто", "этот", "того", "потому", "этого", "какой",
+	"при", "это", "эти", "этом", "должен", "должна", "должно",
+)
+
+// russianSuffixes are inflectional endings stripped by the light Russian stemmer, longest first
+var russianSuffixes = []string{
+	"иями", "ость", "ости", "ются", "ется", "ится", "ание", "ения", "ение",
+	"ями", "ами", "ого", "его", "ому", "ему", "ыми", "ими", "ией", "ать", "ять", "ить", "еть", "ует", "ают", "яют",
+	"ой", "ей", "ий", "ый", "ая", "яя", "ое", "ее", "ые", "ие", "ом", "ем", "ах", "ях", "ам", "ям",
+	"ов", "ев", "ия", "ию", "ть", "ся",
+	"а", "я", "о", "е", "ы", "и", "у", "ю", "ь",
+}
+
+// englishSuffixes are inflectional endings stripped by the light English stemmer, longest first
+var englishSuffixes = []string{
+	"ations", "ation", "ating", "ated", "ates", "ate", "ings", "ing", "ness", "ment", "ies", "ied", "ers", "er", "ed", "ly", "es", "s",
+}
+
+// Tokenize splits text into normalized, stemmed lexical tokens.
+// Russian and English words are lowercased, stop words are removed and
+// inflectional suffixes are stripped so that word forms of the same lemma match.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.ReplaceAll(word, "ё", "е")
+		if utf8.RuneCountInString(word) < 2 {
+			continue
+		}
+		if _, ok := stopWords[word]; ok {
+			continue
+		}
+		tokens = append(tokens, stem(word))
+	}
+
+	return tokens
+}
+
+// stem strips a known inflectional suffix according to the script of the word
+func stem(word string) string {
+	suffixes := englishSuffixes
+	if isCyrillic(word) {
+		suffixes = russianSuffixes
+	}
+
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			base := strings.TrimSuffix(word, suffix)
+			if utf8.RuneCountInString(base) >= minStemLength {
+				return base
+			}
+		}
+	}
+
+	return word
+}
+
+// isCyrillic reports whether the first letter of the word is Cyrillic
+func isCyrillic(word string) bool {
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			return unicode.Is(unicode.Cyrillic, r)
+		}
+	}
+	return false
+}
+
+func makeSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return set
+}
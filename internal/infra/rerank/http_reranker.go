@@ -0,0 +1,124 @@
+package rerank
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// httpReranker scores documents with a cross-encoder behind a Cohere/Jina-compatible /rerank API
+type httpReranker struct {
+	client   *http.Client
+	endpoint string
+	apiKey   string
+	model    string
+}
+
+// rerankRequest is the request body of the /rerank endpoint
+type rerankRequest struct {
+	Model     string   `json:"model"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	TopN      int      `json:"top_n"`
+}
+
+// rerankResponse is the response body of the /rerank endpoint
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float64 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+// NewHTTPReranker creates a reranker for the Cohere/Jina /rerank wire format.
+// Any compatible server (Cohere, Jina, Text Embeddings Inference proxies, a local stand-in) can be used via BaseURL.
+func NewHTTPReranker(cfg *config.RerankConfig) (domain.Reranker, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("rerank base URL is required")
+	}
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("rerank model is required")
+	}
+
+	return &httpReranker{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: strings.TrimRight(cfg.BaseURL, "/") + "/rerank",
+		apiKey:   cfg.APIKey,
+		model:    cfg.Model,
+	}, nil
+}
+
+// Rerank scores all documents with a single API call
+func (r *httpReranker) Rerank(ctx context.Context, query string, documents []string) ([]float64, error) {
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(rerankRequest{
+		Model:     r.model,
+		Query:     query,
+		Documents: documents,
+		TopN:      len(documents),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rerank request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rerank request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+r.apiKey)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call rerank API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rerank response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("rerank API error (status %d): %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var parsed rerankResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode rerank response: %w", err)
+	}
+
+	if len(parsed.Results) != len(documents) {
+		return nil, fmt.Errorf("rerank API returned %d scores for %d documents", len(parsed.Results), len(documents))
+	}
+
+	// Results are sorted by relevance; scores are returned in document order
+	scores := make([]float64, len(documents))
+	seen := make([]bool, len(documents))
+	for _, result := range parsed.Results {
+		if result.Index < 0 || result.Index >= len(documents) || seen[result.Index] {
+			return nil, fmt.Errorf("rerank API returned invalid index %d", result.Index)
+		}
+		seen[result.Index] = true
+		scores[result.Index] = result.RelevanceScore
+	}
+
+	return scores, nil
+}
+
+// Name returns the rerank model name
+func (r *httpReranker) Name() string {
+	return r.model
+}
@@ -0,0 +1,151 @@
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+)
+
+// rerankResult is a single result item of the /rerank API
+type rerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float64 `json:"relevance_score"`
+}
+
+// newRerankServer starts a local stand-in for a /rerank API answering with the given results
+func newRerankServer(t *testing.T, results []rerankResult) (*httptest.Server, *rerankRequest) {
+	t.Helper()
+
+	var received rerankRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rerank" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &received
+}
+
+func newTestReranker(t *testing.T, baseURL string) *httpReranker {
+	t.Helper()
+
+	reranker, err := NewHTTPReranker(&config.RerankConfig{
+		Provider: "http",
+		BaseURL:  baseURL + "/",
+		Model:    "test-reranker",
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewHTTPReranker() error = %v", err)
+	}
+	return reranker.(*httpReranker)
+}
+
+func TestHTTPRerankerReturnsScoresInDocumentOrder(t *testing.T) {
+	// Results come sorted by relevance, not in document order
+	server, received := newRerankServer(t, []rerankResult{
+		{Index: 2, RelevanceScore: 0.9},
+		{Index: 0, RelevanceScore: 0.5},
+		{Index: 1, RelevanceScore: 0.1},
+	})
+	reranker := newTestReranker(t, server.URL)
+
+	documents := []string{"first", "second", "third"}
+	scores, err := reranker.Rerank(context.Background(), "query", documents)
+	if err != nil {
+		t.Fatalf("Rerank() error = %v", err)
+	}
+
+	if want := []float64{0.5, 0.1, 0.9}; !reflect.DeepEqual(scores, want) {
+		t.Errorf("Rerank() = %v, want %v", scores, want)
+	}
+	if received.Model != "test-reranker" || received.Query != "query" || received.TopN != len(documents) ||
+		!reflect.DeepEqual(received.Documents, documents) {
+		t.Errorf("request = %+v", *received)
+	}
+}
+
+func TestHTTPRerankerRejectsInvalidResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []rerankResult
+		wantErr string
+	}{
+		{
+			name:    "duplicate index",
+			results: []rerankResult{{Index: 0}, {Index: 0}},
+			wantErr: "invalid index 0",
+		},
+		{
+			name:    "index out of range",
+			results: []rerankResult{{Index: 0}, {Index: 2}},
+			wantErr: "invalid index 2",
+		},
+		{
+			name:    "negative index",
+			results: []rerankResult{{Index: -1}, {Index: 1}},
+			wantErr: "invalid index -1",
+		},
+		{
+			name:    "too few results",
+			results: []rerankResult{{Index: 0}},
+			wantErr: "returned 1 scores for 2 documents",
+		},
+		{
+			name:    "too many results",
+			results: []rerankResult{{Index: 0}, {Index: 1}, {Index: 1}},
+			wantErr: "returned 3 scores for 2 documents",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newRerankServer(t, tt.results)
+			reranker := newTestReranker(t, server.URL)
+
+			_, err := reranker.Rerank(context.Background(), "query", []string{"first", "second"})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Rerank() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHTTPRerankerAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model overloaded", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	reranker := newTestReranker(t, server.URL)
+
+	_, err := reranker.Rerank(context.Background(), "query", []string{"first"})
+	if err == nil || !strings.Contains(err.Error(), "status 503") || !strings.Contains(err.Error(), "model overloaded") {
+		t.Errorf("Rerank() error = %v, want status 503 with the response body", err)
+	}
+}
+
+func TestHTTPRerankerSkipsEmptyDocuments(t *testing.T) {
+	// The reranker must not call the API without documents
+	reranker := newTestReranker(t, "http://127.0.0.1:0")
+
+	scores, err := reranker.Rerank(context.Background(), "query", nil)
+	if err != nil || scores != nil {
+		t.Errorf("Rerank() = %v, %v, want nil, nil", scores, err)
+	}
+}
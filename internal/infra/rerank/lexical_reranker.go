@@ -0,0 +1,47 @@
+package rerank
+
+import (
+	"context"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+// lexicalReranker scores documents by the share of query terms they contain.
+// It needs no external service and complements vector similarity with exact term matches.
+type lexicalReranker struct{}
+
+// NewLexicalReranker creates a reranker based on query term overlap
+func NewLexicalReranker() domain.Reranker {
+	return &lexicalReranker{}
+}
+
+// Rerank returns the share of distinct query terms found in every document, from 0 to 1
+func (r *lexicalReranker) Rerank(ctx context.Context, query string, documents []string) ([]float64, error) {
+	queryTerms := make(map[string]struct{})
+	for _, token := range embeddings.Tokenize(query) {
+		queryTerms[token] = struct{}{}
+	}
+
+	scores := make([]float64, len(documents))
+	if len(queryTerms) == 0 {
+		return scores, nil
+	}
+
+	for i, document := range documents {
+		found := make(map[string]struct{})
+		for _, token := range embeddings.Tokenize(document) {
+			if _, ok := queryTerms[token]; ok {
+				found[token] = struct{}{}
+			}
+		}
+		scores[i] = float64(len(found)) / float64(len(queryTerms))
+	}
+
+	return scores, nil
+}
+
+// Name returns the reranker name
+func (r *lexicalReranker) Name() string {
+	return "lexical"
+}
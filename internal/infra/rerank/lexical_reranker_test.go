@@ -0,0 +1,58 @@
+package rerank
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestLexicalReranker(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		documents []string
+		want      []float64
+	}{
+		{
+			name:  "term share",
+			query: "validate email format",
+			documents: []string{
+				"Email format is validated on signup",
+				"Email is required",
+				"Orders need approval",
+			},
+			want: []float64{1, 1.0 / 3, 0},
+		},
+		{
+			name:      "repeated terms count once",
+			query:     "email email address",
+			documents: []string{"email email email"},
+			want:      []float64{0.5},
+		},
+		{
+			name:      "russian word forms",
+			query:     "формат почты",
+			documents: []string{"Почта в неверном формате", "Адрес почты"},
+			want:      []float64{1, 0.5},
+		},
+		{
+			name:      "query of stop words",
+			query:     "the and of",
+			documents: []string{"the rules"},
+			want:      []float64{0},
+		},
+	}
+
+	reranker := NewLexicalReranker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores, err := reranker.Rerank(context.Background(), tt.query, tt.documents)
+			if err != nil {
+				t.Fatalf("Rerank() error = %v", err)
+			}
+			if !reflect.DeepEqual(scores, tt.want) {
+				t.Errorf("Rerank() = %v, want %v", scores, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package rerank
+
+import (
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/config"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// NewReranker creates the reranker selected by configuration; nil means reranking is disabled
+func NewReranker(cfg *config.RerankConfig) (domain.Reranker, error) {
+	switch cfg.Provider {
+	case "", "none":
+		return nil, nil
+	case "lexical":
+		return NewLexicalReranker(), nil
+	case "http":
+		return NewHTTPReranker(cfg)
+	default:
+		return nil, fmt.Errorf("unknown rerank provider %q", cfg.Provider)
+	}
+}
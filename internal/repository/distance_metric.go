@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// vectorDistance returns the expression of the distance between a chunk vector and the query vector ($1).
+// Vectors are cast to the fixed query size so that per-model expression indexes can be used; ordering
+// by the distance uses the index built with the operator class of the metric.
+func vectorDistance(metric domain.DistanceMetric, dimensions int) string {
+	operator := "<=>"
+	switch metric {
+	case domain.DistanceL2:
+		operator = "<->"
+	case domain.DistanceInnerProduct:
+		// Negative inner product, so that smaller is closer as for the other operators
+		operator = "<#>"
+	}
+	return fmt.Sprintf("c.embedding::vector(%d) %s $1", dimensions, operator)
+}
+
+// modelCondition returns the condition selecting vectors of the model, with the model name inlined
+// as a literal. Per-model indexes are partial on the model name, and a bound parameter would keep
+// generic plans of cached prepared statements from using them.
+func modelCondition(column, model string) string {
+	return column + " = '" + strings.ReplaceAll(model, "'", "''") + "'"
+}
+
+// vectorOperatorClass returns the operator class of vector indexes serving the metric
+func vectorOperatorClass(metric domain.DistanceMetric) string {
+	switch metric {
+	case domain.DistanceL2:
+		return "vector_l2_ops"
+	case domain.DistanceInnerProduct:
+		return "vector_ip_ops"
+	default:
+		return "vector_cosine_ops"
+	}
+}
+
+// distanceOperators are the pgvector operators of all metrics
+var distanceOperators = []string{"<=>", "<->", "<#>"}
+
+// vectorIndexScan reports whether the execution plan orders chunks by distance through a vector index.
+// Such index scans list the distance as their Order By; without an index, a Sort node orders them instead.
+func vectorIndexScan(plan []string) bool {
+	for _, line := range plan {
+		if !strings.Contains(line, "Order By:") {
+			continue
+		}
+		for _, operator := range distanceOperators {
+			if strings.Contains(line, operator) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// vectorSimilarity converts the distance expression of the metric to a similarity score, higher is closer.
+// Vectors are stored and searched at unit length, so every metric yields cosine similarity and
+// thresholds and score calibration do not depend on the metric.
+func vectorSimilarity(metric domain.DistanceMetric, distance string) string {
+	switch metric {
+	case domain.DistanceL2:
+		// |a - b|^2 = 2 - 2 * cos(a, b) for unit vectors
+		return fmt.Sprintf("1 - power(%s, 2) / 2", distance)
+	case domain.DistanceInnerProduct:
+		return fmt.Sprintf("-(%s)", distance)
+	default:
+		return fmt.Sprintf("1 - (%s)", distance)
+	}
+}
@@ -0,0 +1,54 @@
+package repository
+
+import "testing"
+
+func TestModelCondition(t *testing.T) {
+	tests := []struct {
+		model string
+		want  string
+	}{
+		{model: "text-embedding-3-small", want: "c.model = 'text-embedding-3-small'"},
+		{model: "it's", want: "c.model = 'it''s'"},
+		{model: "x' OR '1'='1", want: "c.model = 'x'' OR ''1''=''1'"},
+	}
+
+	for _, tt := range tests {
+		if got := modelCondition("c.model", tt.model); got != tt.want {
+			t.Errorf("modelCondition(%q) = %s, want %s", tt.model, got, tt.want)
+		}
+	}
+}
+
+func TestVectorIndexScan(t *testing.T) {
+	tests := []struct {
+		name string
+		plan []string
+		want bool
+	}{
+		{
+			name: "hnsw index",
+			plan: []string{
+				"Limit  (cost=8.14..9.31 rows=40 width=52)",
+				"  ->  Index Scan using idx_rule_embedding_chunks_text_embedding_3_small on rule_embedding_chunks c",
+				"        Order By: ((embedding)::vector(1536) <=> $1)",
+			},
+			want: true,
+		},
+		{
+			name: "sequential scan",
+			plan: []string{
+				"Limit  (cost=25.88..25.98 rows=40 width=52)",
+				"  ->  Sort  (cost=25.88..26.01 rows=52 width=52)",
+				"        Sort Key: (((embedding)::vector(1536) <=> $1))",
+				"        ->  Seq Scan on rule_embedding_chunks c",
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := vectorIndexScan(tt.plan); got != tt.want {
+			t.Errorf("vectorIndexScan(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
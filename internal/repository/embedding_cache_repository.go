@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pgvector/pgvector-go"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+type embeddingCacheRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewEmbeddingCacheRepository creates a new persistent embedding cache repository
+func NewEmbeddingCacheRepository(db *pgxpool.Pool) domain.EmbeddingCacheRepository {
+	return &embeddingCacheRepository{db: db}
+}
+
+func (r *embeddingCacheRepository) GetMany(ctx context.Context, model string, hashes []string) (map[string][]float32, error) {
+	const query = `
+		SELECT content_hash, embedding
+		FROM embedding_cache
+		WHERE model = $1 AND content_hash = ANY($2)`
+
+	rows, err := r.db.Query(ctx, query, model, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cached embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]float32, len(hashes))
+	for rows.Next() {
+		var hash, embeddingStr string
+		if err := rows.Scan(&hash, &embeddingStr); err != nil {
+			return nil, fmt.Errorf("failed to scan cached embedding: %w", err)
+		}
+
+		var embedding pgvector.Vector
+		if err := embedding.Scan(embeddingStr); err != nil {
+			return nil, fmt.Errorf("failed to parse cached embedding: %w", err)
+		}
+		result[hash] = embedding.Slice()
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cached embeddings: %w", err)
+	}
+
+	return result, nil
+}
+
+// PutMany stores embeddings, replacing cached ones of the same text and model.
+// Entries left from other dimensions are skipped on read and overwritten here.
+func (r *embeddingCacheRepository) PutMany(ctx context.Context, model string, embeddings map[string][]float32) error {
+	const query = `
+		INSERT INTO embedding_cache (content_hash, model, dimensions, embedding)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (content_hash, model) DO UPDATE
+		SET dimensions = EXCLUDED.dimensions, embedding = EXCLUDED.embedding, created_at = NOW()`
+
+	batch := &pgx.Batch{}
+	for hash, embedding := range embeddings {
+		batch.Queue(query, hash, model, len(embedding), pgvector.NewVector(embedding))
+	}
+
+	if err := r.db.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to store cached embeddings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// filterBuilder translates metadata filters into parameterized conditions on r.content.
+// Values are always passed as arguments, never interpolated into SQL.
+type filterBuilder struct {
+	args []interface{}
+}
+
+// metadataCondition builds the SQL condition of a filter, appending its arguments to args
+func metadataCondition(filter *domain.MetadataFilter, args []interface{}) (string, []interface{}, error) {
+	b := &filterBuilder{args: args}
+	condition, err := b.condition(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	return condition, b.args, nil
+}
+
+// arg adds an argument and returns its placeholder
+func (b *filterBuilder) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *filterBuilder) condition(filter *domain.MetadataFilter) (string, error) {
+	switch filter.Op {
+	case domain.FilterAnd, domain.FilterOr:
+		conditions := make([]string, len(filter.Filters))
+		for i := range filter.Filters {
+			condition, err := b.condition(&filter.Filters[i])
+			if err != nil {
+				return "", err
+			}
+			conditions[i] = condition
+		}
+		return "(" + strings.Join(conditions, " "+strings.ToUpper(string(filter.Op))+" ") + ")", nil
+	}
+
+	segments, err := filter.PathSegments()
+	if err != nil {
+		return "", err
+	}
+	// Every argument must be referenced, so the path is added only where it is used
+	path := func() string {
+		return fmt.Sprintf("(r.content #> %s::text[])", b.arg(segments))
+	}
+
+	switch filter.Op {
+	case domain.FilterEq:
+		condition := fmt.Sprintf("%s = %s::jsonb", path(), b.arg(string(filter.Value)))
+		// Containment narrows candidates through the GIN index on content
+		if document, ok := containmentDocument(segments, filter.Value); ok {
+			condition = fmt.Sprintf("(r.content @> %s::jsonb AND %s)", b.arg(document), condition)
+		}
+		return condition, nil
+	case domain.FilterNe:
+		return fmt.Sprintf("%s IS DISTINCT FROM %s::jsonb", path(), b.arg(string(filter.Value))), nil
+	case domain.FilterIn:
+		values, err := json.Marshal(filter.Values)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode filter values: %w", err)
+		}
+		return fmt.Sprintf("%s IN (SELECT jsonb_array_elements(%s::jsonb))", path(), b.arg(string(values))), nil
+	case domain.FilterRange:
+		return b.rangeCondition(path(), filter), nil
+	case domain.FilterExists:
+		return path() + " IS NOT NULL", nil
+	case domain.FilterContains:
+		// A scalar is contained in an array holding it
+		target := filter.Value
+		if isJSONScalar(target) {
+			target = json.RawMessage("[" + string(target) + "]")
+		}
+		if document, ok := containmentDocument(segments, target); ok {
+			return fmt.Sprintf("r.content @> %s::jsonb", b.arg(document)), nil
+		}
+		return fmt.Sprintf("%s @> %s::jsonb", path(), b.arg(string(target))), nil
+	default:
+		return "", fmt.Errorf("%w: unknown filter operator '%s'", domain.ErrInvalidInput, filter.Op)
+	}
+}
+
+// rangeCondition compares the value with the bounds; values of other JSON types never match,
+// since jsonb orders different types by type rather than by value
+func (b *filterBuilder) rangeCondition(value string, filter *domain.MetadataFilter) string {
+	valueType := "number"
+	conditions := []string{}
+	for _, bound := range []struct {
+		op    string
+		value json.RawMessage
+	}{{">", filter.Gt}, {">=", filter.Gte}, {"<", filter.Lt}, {"<=", filter.Lte}} {
+		if len(bound.value) == 0 {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(string(bound.value)), `"`) {
+			valueType = "string"
+		}
+		conditions = append(conditions, fmt.Sprintf("%s %s %s::jsonb", value, bound.op, b.arg(string(bound.value))))
+	}
+
+	conditions = append([]string{fmt.Sprintf("jsonb_typeof(%s) = '%s'", value, valueType)}, conditions...)
+	return "(" + strings.Join(conditions, " AND ") + ")"
+}
+
+// containmentDocument nests the value under the path segments, so that content @> document
+// holds when the value at the path contains the value. Paths that may address array
+// elements cannot be expressed as containment.
+func containmentDocument(segments []string, value json.RawMessage) (string, bool) {
+	document := value
+	for i := len(segments) - 1; i >= 0; i-- {
+		if isArrayIndex(segments[i]) {
+			return "", false
+		}
+		nested, err := json.Marshal(map[string]json.RawMessage{segments[i]: document})
+		if err != nil {
+			return "", false
+		}
+		document = nested
+	}
+	return string(document), true
+}
+
+// isArrayIndex reports whether a path segment may address an array element
+func isArrayIndex(segment string) bool {
+	if segment == "-" {
+		return true
+	}
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isJSONScalar reports whether the JSON value is neither an array nor an object
+func isJSONScalar(value json.RawMessage) bool {
+	trimmed := strings.TrimSpace(string(value))
+	return trimmed != "" && trimmed[0] != '[' && trimmed[0] != '{'
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+type reindexJobRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewReindexJobRepository creates a new reindex job repository
+func NewReindexJobRepository(db *pgxpool.Pool) domain.ReindexJobRepository {
+	return &reindexJobRepository{db: db}
+}
+
+const reindexJobColumns = `id, model, scope, rule_type, batch_size, max_rules_per_second, status,
+		total, processed, last_rule_id, error, created_at, updated_at, finished_at`
+
+func (r *reindexJobRepository) Create(ctx context.Context, job *domain.ReindexJob) (*domain.ReindexJob, error) {
+	const query = `
+		INSERT INTO reindex_jobs (model, scope, rule_type, batch_size, max_rules_per_second, status, total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at`
+
+	var result domain.ReindexJob
+	result = *job
+
+	err := r.db.QueryRow(ctx, query, job.Model, job.Scope, job.RuleType, job.BatchSize,
+		job.MaxRulesPerSecond, job.Status, job.Total).
+		Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reindex job: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (r *reindexJobRepository) GetByID(ctx context.Context, id int64) (*domain.ReindexJob, error) {
+	query := `SELECT ` + reindexJobColumns + ` FROM reindex_jobs WHERE id = $1`
+
+	job, err := scanReindexJob(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrReindexJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get reindex job by id: %w", err)
+	}
+
+	return job, nil
+}
+
+func (r *reindexJobRepository) Update(ctx context.Context, job *domain.ReindexJob) error {
+	const query = `
+		UPDATE reindex_jobs
+		SET status = $2, total = $3, processed = $4, last_rule_id = $5, error = $6, finished_at = $7
+		WHERE id = $1 AND status = 'running'
+		RETURNING updated_at`
+
+	// The status condition keeps a job canceled concurrently from being written back as running
+	err := r.db.QueryRow(ctx, query, job.ID, job.Status, job.Total, job.Processed,
+		job.LastRuleID, job.Error, job.FinishedAt).
+		Scan(&job.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrReindexJobNotRunning
+		}
+		return fmt.Errorf("failed to update reindex job: %w", err)
+	}
+
+	return nil
+}
+
+func (r *reindexJobRepository) List(ctx context.Context, limit, offset int) ([]*domain.ReindexJob, error) {
+	query := `SELECT ` + reindexJobColumns + ` FROM reindex_jobs ORDER BY id DESC LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reindex jobs: %w", err)
+	}
+
+	return collectReindexJobs(rows)
+}
+
+func (r *reindexJobRepository) ListByStatus(ctx context.Context, status domain.ReindexStatus) ([]*domain.ReindexJob, error) {
+	query := `SELECT ` + reindexJobColumns + ` FROM reindex_jobs WHERE status = $1 ORDER BY id`
+
+	rows, err := r.db.Query(ctx, query, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reindex jobs by status: %w", err)
+	}
+
+	return collectReindexJobs(rows)
+}
+
+// collectReindexJobs scans all rows into reindex jobs and closes them
+func collectReindexJobs(rows pgx.Rows) ([]*domain.ReindexJob, error) {
+	defer rows.Close()
+
+	var jobs []*domain.ReindexJob
+	for rows.Next() {
+		job, err := scanReindexJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reindex job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reindex jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// scanReindexJob scans a row selected with reindexJobColumns
+func scanReindexJob(row pgx.Row) (*domain.ReindexJob, error) {
+	var job domain.ReindexJob
+	err := row.Scan(
+		&job.ID,
+		&job.Model,
+		&job.Scope,
+		&job.RuleType,
+		&job.BatchSize,
+		&job.MaxRulesPerSecond,
+		&job.Status,
+		&job.Total,
+		&job.Processed,
+		&job.LastRuleID,
+		&job.Error,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -2,8 +2,8 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,44 +22,50 @@ func NewRuleRepository(db *pgxpool.Pool) domain.RuleRepository {
 
 func (r *ruleRepository) Create(ctx context.Context, rule *domain.Rule) (*domain.Rule, error) {
 	const query = `
-		INSERT INTO rules (rule_type_id, content, embedding)
-		VALUES ($1, $2, $3)
+		INSERT INTO rules (rule_type_id, content)
+		VALUES ($1, $2)
 		RETURNING id, created_at, updated_at`
 
-	var embedding interface{}
-	if rule.Embedding != nil {
-		embedding = pgvector.NewVector(rule.Embedding)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
 	var result domain.Rule
 	result = *rule
 
-	err := r.db.QueryRow(ctx, query, rule.RuleTypeID, rule.Content, embedding).
+	err = tx.QueryRow(ctx, query, rule.RuleTypeID, rule.Content).
 		Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rule: %w", err)
 	}
 
+	if err := insertEmbeddings(ctx, tx, result.ID, result.Embeddings); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit rule creation: %w", err)
+	}
+
 	return &result, nil
 }
 
 func (r *ruleRepository) GetByID(ctx context.Context, id int64) (*domain.Rule, error) {
 	const query = `
-		SELECT r.id, r.rule_type_id, r.content, r.embedding, r.created_at, r.updated_at,
+		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
 		       rt.name as rule_type_name
 		FROM rules r
 		JOIN rule_types rt ON r.rule_type_id = rt.id
 		WHERE r.id = $1`
 
 	var rule domain.Rule
-	var embedding pgvector.Vector
-	var embeddingNull sql.NullString
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&rule.ID,
 		&rule.RuleTypeID,
 		&rule.Content,
-		&embeddingNull,
 		&rule.CreatedAt,
 		&rule.UpdatedAt,
 		&rule.RuleTypeName,
@@ -71,28 +77,31 @@ func (r *ruleRepository) GetByID(ctx context.Context, id int64) (*domain.Rule, e
 		return nil, fmt.Errorf("failed to get rule by id: %w", err)
 	}
 
-	if embeddingNull.Valid {
-		if err := embedding.Scan(embeddingNull.String); err == nil {
-			rule.Embedding = embedding.Slice()
-		}
+	embeddings, err := r.getEmbeddings(ctx, id)
+	if err != nil {
+		return nil, err
 	}
+	rule.Embeddings = embeddings
 
 	return &rule, nil
 }
 
+// Update updates rule content. When rule.Embeddings is not nil, all stored embeddings
+// are replaced, since vectors of other models no longer match the new content.
 func (r *ruleRepository) Update(ctx context.Context, rule *domain.Rule) (*domain.Rule, error) {
 	const query = `
 		UPDATE rules 
-		SET rule_type_id = $2, content = $3, embedding = $4, updated_at = NOW()
+		SET rule_type_id = $2, content = $3, updated_at = NOW()
 		WHERE id = $1
 		RETURNING updated_at`
 
-	var embedding interface{}
-	if rule.Embedding != nil {
-		embedding = pgvector.NewVector(rule.Embedding)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	err := r.db.QueryRow(ctx, query, rule.ID, rule.RuleTypeID, rule.Content, embedding).
+	err = tx.QueryRow(ctx, query, rule.ID, rule.RuleTypeID, rule.Content).
 		Scan(&rule.UpdatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -101,6 +110,19 @@ func (r *ruleRepository) Update(ctx context.Context, rule *domain.Rule) (*domain
 		return nil, fmt.Errorf("failed to update rule: %w", err)
 	}
 
+	if rule.Embeddings != nil {
+		if _, err := tx.Exec(ctx, `DELETE FROM rule_embeddings WHERE rule_id = $1`, rule.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete rule embeddings: %w", err)
+		}
+		if err := insertEmbeddings(ctx, tx, rule.ID, rule.Embeddings); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit rule update: %w", err)
+	}
+
 	return rule, nil
 }
 
@@ -119,23 +141,36 @@ func (r *ruleRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *ruleRepository) List(ctx context.Context, ruleType *string, limit, offset int) ([]*domain.Rule, error) {
+func (r *ruleRepository) List(ctx context.Context, types *domain.RuleTypeFilter, filter *domain.MetadataFilter, limit, offset int) ([]*domain.Rule, error) {
 	query := `
 		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
 		       rt.name as rule_type_name
 		FROM rules r
 		JOIN rule_types rt ON r.rule_type_id = rt.id`
 
+	var conditions []string
 	var args []interface{}
-	argIndex := 1
 
-	if ruleType != nil {
-		query += " WHERE rt.name = $" + fmt.Sprintf("%d", argIndex)
-		args = append(args, *ruleType)
-		argIndex++
+	if !types.IsEmpty() {
+		var condition string
+		condition, args = typeCondition(types, args)
+		conditions = append(conditions, condition)
 	}
 
-	query += fmt.Sprintf(" ORDER BY r.created_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	if filter != nil {
+		condition, filterArgs, err := metadataCondition(filter, args)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+		args = filterArgs
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY r.created_at DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
 	args = append(args, limit, offset)
 
 	rows, err := r.db.Query(ctx, query, args...)
@@ -168,38 +203,89 @@ func (r *ruleRepository) List(ctx context.Context, ruleType *string, limit, offs
 	return rules, nil
 }
 
-func (r *ruleRepository) FindSimilar(ctx context.Context, embedding []float32, ruleType *string, limit int) ([]*domain.RuleMatch, error) {
-	query := `
-		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
-		       rt.name as rule_type_name,
-		       1 - (r.embedding <=> $1) as similarity_score
-		FROM rules r
-		JOIN rule_types rt ON r.rule_type_id = rt.id
-		WHERE r.embedding IS NOT NULL`
-
-	var args []interface{}
-	args = append(args, pgvector.NewVector(embedding))
-	argIndex := 2
+// chunkCandidateFactor is how many nearest chunks are fetched per requested rule,
+// so that rules with several close chunks do not crowd out other rules
+const chunkCandidateFactor = 4
+
+// maxChunkCandidates bounds the nearest chunks fetched while looking for enough distinct rules
+const maxChunkCandidates = 10000
+
+// hybridRRFK dampens the influence of top ranks when vector and text ranks are fused
+const hybridRRFK = 60
+
+// textSearchQuery matches any query term stemmed with the Russian or the English configuration.
+// plainto_tsquery joins terms with AND, which is too strict for natural language queries.
+const textSearchQuery = `replace(plainto_tsquery('russian', $%[1]d)::text, '&', '|')::tsquery` +
+	` || replace(plainto_tsquery('english', $%[1]d)::text, '&', '|')::tsquery`
+
+// FindSimilar returns the rules best matching the search. Candidates are nearest chunks,
+// and rules with many close chunks can take most of them: the search is repeated with
+// more candidates until it finds enough rules or no more chunks are left.
+func (r *ruleRepository) FindSimilar(ctx context.Context, search *domain.SimilaritySearch) ([]*domain.RuleMatch, error) {
+	candidates := search.Limit * chunkCandidateFactor
+	for {
+		var matches []*domain.RuleMatch
+		fetched, err := r.findSimilar(ctx, search, candidates, func(match *domain.RuleMatch) error {
+			matches = append(matches, match)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) >= search.Limit || fetched < candidates || candidates >= maxChunkCandidates {
+			return matches, nil
+		}
+		candidates = min(candidates*chunkCandidateFactor, maxChunkCandidates)
+	}
+}
 
-	if ruleType != nil {
-		query += " AND rt.name = $" + fmt.Sprintf("%d", argIndex)
-		args = append(args, *ruleType)
-		argIndex++
+// StreamSimilar passes the rules of FindSimilar to emit as rows are read from the database.
+// A repeated search with more candidates emits only rules not emitted before, after them,
+// and stops at the limit. Nearest chunks of the emitted rules stay among the candidates, so in
+// vector mode the result is the same as FindSimilar. In hybrid modes rules found by a repeated
+// search may outrank emitted ones: they are emitted after them, and a rule FindSimilar would
+// return in place of an emitted one is left out.
+func (r *ruleRepository) StreamSimilar(ctx context.Context, search *domain.SimilaritySearch, emit func(*domain.RuleMatch) error) error {
+	emitted := make(map[int64]struct{})
+	candidates := search.Limit * chunkCandidateFactor
+	for {
+		fetched, err := r.findSimilar(ctx, search, candidates, func(match *domain.RuleMatch) error {
+			if _, ok := emitted[match.ID]; ok || len(emitted) >= search.Limit {
+				return nil
+			}
+			emitted[match.ID] = struct{}{}
+			return emit(match)
+		})
+		if err != nil {
+			return err
+		}
+		if len(emitted) >= search.Limit || fetched < candidates || candidates >= maxChunkCandidates {
+			return nil
+		}
+		candidates = min(candidates*chunkCandidateFactor, maxChunkCandidates)
 	}
+}
 
-	query += fmt.Sprintf(" ORDER BY r.embedding <=> $1 LIMIT $%d", argIndex)
-	args = append(args, limit)
+// findSimilar runs the search over the given number of candidate chunks, passing every match to emit.
+// It returns the number of candidate chunks found, fewer when the search ran out of chunks.
+func (r *ruleRepository) findSimilar(ctx context.Context, search *domain.SimilaritySearch, candidates int, emit func(*domain.RuleMatch) error) (int, error) {
+	query, args, err := similaritySearchQuery(search, candidates)
+	if err != nil {
+		return 0, err
+	}
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find similar rules: %w", err)
+		return 0, fmt.Errorf("failed to find similar rules: %w", err)
 	}
 	defer rows.Close()
 
-	var matches []*domain.RuleMatch
+	var fetched int64
 	for rows.Next() {
 		var match domain.RuleMatch
-		err := rows.Scan(
+		var chunk domain.RuleChunk
+		var chunkVectorStr, vectorStr string
+		dest := []interface{}{
 			&match.ID,
 			&match.RuleTypeID,
 			&match.Content,
@@ -207,27 +293,334 @@ func (r *ruleRepository) FindSimilar(ctx context.Context, embedding []float32, r
 			&match.UpdatedAt,
 			&match.RuleTypeName,
 			&match.Score,
+			&chunk.Index,
+			&chunk.Text,
+		}
+		if search.IncludeChunkEmbeddings {
+			dest = append(dest, &chunkVectorStr)
+		}
+		if search.IncludeEmbeddings {
+			dest = append(dest, &vectorStr)
+		}
+		dest = append(dest, &fetched)
+		if err := rows.Scan(dest...); err != nil {
+			return 0, fmt.Errorf("failed to scan rule match: %w", err)
+		}
+		match.Model = search.Model
+		match.Chunk = &chunk
+
+		if search.IncludeChunkEmbeddings {
+			var vector pgvector.Vector
+			if err := vector.Scan(chunkVectorStr); err != nil {
+				return 0, fmt.Errorf("failed to parse chunk embedding: %w", err)
+			}
+			chunk.Vector = vector.Slice()
+		}
+
+		if search.IncludeEmbeddings {
+			var vector pgvector.Vector
+			if err := vector.Scan(vectorStr); err != nil {
+				return 0, fmt.Errorf("failed to parse rule embedding: %w", err)
+			}
+			match.Embeddings = []domain.RuleEmbedding{{
+				Model:      search.Model,
+				Dimensions: len(vector.Slice()),
+				Vector:     vector.Slice(),
+			}}
+		}
+
+		if err := emit(&match); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating rule matches: %w", err)
+	}
+
+	return int(fetched), nil
+}
+
+// ExplainSimilar returns the execution plan of the first similarity search query.
+// A warning line is added when the plan orders chunks without a vector index.
+func (r *ruleRepository) ExplainSimilar(ctx context.Context, search *domain.SimilaritySearch) ([]string, error) {
+	query, args, err := similaritySearchQuery(search, search.Limit*chunkCandidateFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, "EXPLAIN "+query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain similarity search: %w", err)
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return nil, fmt.Errorf("failed to scan query plan: %w", err)
+		}
+		plan = append(plan, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating query plan: %w", err)
+	}
+
+	// Without an index of the model, size and metric every chunk of the model is compared
+	if !vectorIndexScan(plan) {
+		plan = append(plan, fmt.Sprintf("WARNING: no vector index serves the search: create an hnsw index on "+
+			"(embedding::vector(%d)) %s WHERE model = '%s'", len(search.Embedding), vectorOperatorClass(search.Metric), search.Model))
+	}
+
+	return plan, nil
+}
+
+// similaritySearchQuery builds the query of the search mode over the given number of candidate chunks.
+// Every row ends with the number of candidate chunks found.
+func similaritySearchQuery(search *domain.SimilaritySearch, candidates int) (string, []interface{}, error) {
+	switch search.Mode {
+	case domain.SearchModeHybrid, domain.SearchModeHybridRRF:
+		return hybridSearchQuery(search, candidates)
+	default:
+		return vectorSearchQuery(search, candidates)
+	}
+}
+
+// vectorSearchQuery builds the query scoring rules by the vector similarity of their best chunk
+func vectorSearchQuery(search *domain.SimilaritySearch, candidates int) (string, []interface{}, error) {
+	dimensions := len(search.Embedding)
+	distance := vectorDistance(search.Metric, dimensions)
+	args := []interface{}{pgvector.NewVector(search.Embedding)}
+	filter, args, err := searchFilter(search, args)
+	if err != nil {
+		return "", nil, err
+	}
+	argIndex := len(args) + 1
+
+	// Nearest chunks are found through the vector index, then every rule is scored by its best chunk
+	query := fmt.Sprintf(`
+		WITH nearest AS (
+			SELECT c.rule_id, c.chunk_index, c.content AS chunk_text,
+			       %[8]s AS similarity_score%[10]s
+			FROM rule_embedding_chunks c
+			JOIN rules r ON r.id = c.rule_id
+			JOIN rule_types rt ON r.rule_type_id = rt.id
+			WHERE %[13]s AND c.dimensions = %[1]d%[2]s
+			ORDER BY %[9]s
+			LIMIT $%[3]d
+		), best AS (
+			SELECT DISTINCT ON (rule_id) rule_id, chunk_index, chunk_text, similarity_score%[11]s
+			FROM nearest
+			ORDER BY rule_id, similarity_score DESC
 		)
+		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
+		       rt.name as rule_type_name,
+		       b.similarity_score, b.chunk_index, COALESCE(b.chunk_text, '')%[12]s%[6]s,
+		       (SELECT count(*) FROM nearest) AS candidate_chunks
+		FROM best b
+		JOIN rules r ON r.id = b.rule_id
+		JOIN rule_types rt ON r.rule_type_id = rt.id%[7]s%[5]s
+		ORDER BY b.similarity_score DESC
+		LIMIT $%[4]d`, dimensions, filter, argIndex, argIndex+1, minScoreCondition("b.similarity_score", search.MinScore, argIndex+2),
+		embeddingColumn(search), embeddingJoin(search), vectorSimilarity(search.Metric, distance), distance,
+		chunkEmbeddingColumn(search, "c.embedding AS chunk_embedding"), chunkEmbeddingColumn(search, "chunk_embedding"),
+		chunkEmbeddingColumn(search, "b.chunk_embedding"), modelCondition("c.model", search.Model))
+	args = append(args, candidates, search.Limit)
+	if search.MinScore != nil {
+		args = append(args, *search.MinScore)
+	}
+
+	return query, args, nil
+}
+
+// typeCondition builds the condition selecting rules of the filtered types, appending its arguments to args
+func typeCondition(types *domain.RuleTypeFilter, args []interface{}) (string, []interface{}) {
+	var conditions []string
+
+	var included []string
+	if len(types.Include) > 0 {
+		args = append(args, types.Include)
+		included = append(included, fmt.Sprintf("rt.name = ANY($%d)", len(args)))
+	}
+	if len(types.IncludeIDs) > 0 {
+		args = append(args, types.IncludeIDs)
+		included = append(included, fmt.Sprintf("rt.id = ANY($%d)", len(args)))
+	}
+	if len(included) > 0 {
+		conditions = append(conditions, "("+strings.Join(included, " OR ")+")")
+	}
+
+	if len(types.Exclude) > 0 {
+		args = append(args, types.Exclude)
+		conditions = append(conditions, fmt.Sprintf("rt.name <> ALL($%d)", len(args)))
+	}
+	if len(types.ExcludeIDs) > 0 {
+		args = append(args, types.ExcludeIDs)
+		conditions = append(conditions, fmt.Sprintf("rt.id <> ALL($%d)", len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// searchFilter builds the conditions restricting similarity search candidates, appending their arguments to args
+func searchFilter(search *domain.SimilaritySearch, args []interface{}) (string, []interface{}, error) {
+	filter := ""
+
+	if !search.Types.IsEmpty() {
+		var condition string
+		condition, args = typeCondition(search.Types, args)
+		filter += " AND " + condition
+	}
+
+	if len(search.ExcludeIDs) > 0 {
+		args = append(args, search.ExcludeIDs)
+		filter += fmt.Sprintf(" AND r.id <> ALL($%d)", len(args))
+	}
+
+	if search.Filter != nil {
+		condition, filterArgs, err := metadataCondition(search.Filter, args)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan rule match: %w", err)
+			return "", nil, err
 		}
-		matches = append(matches, &match)
+		filter += " AND " + condition
+		args = filterArgs
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating rule matches: %w", err)
+	return filter, args, nil
+}
+
+// embeddingColumn returns the select list entry of the pooled rule vector, if requested
+func embeddingColumn(search *domain.SimilaritySearch) string {
+	if !search.IncludeEmbeddings {
+		return ""
+	}
+	return ", e.embedding"
+}
+
+// chunkEmbeddingColumn returns the select list entry of the best chunk vector from source, if requested
+func chunkEmbeddingColumn(search *domain.SimilaritySearch, source string) string {
+	if !search.IncludeChunkEmbeddings {
+		return ""
+	}
+	return ", " + source
+}
+
+// embeddingJoin returns the join of pooled rule vectors of the search model, if requested
+func embeddingJoin(search *domain.SimilaritySearch) string {
+	if !search.IncludeEmbeddings {
+		return ""
 	}
+	return "\n\t\tJOIN rule_embeddings e ON e.rule_id = r.id AND " + modelCondition("e.model", search.Model)
+}
 
-	return matches, nil
+// minScoreCondition returns the WHERE clause dropping matches scored below the threshold, if any
+func minScoreCondition(score string, minScore *float64, argIndex int) string {
+	if minScore == nil {
+		return ""
+	}
+	return fmt.Sprintf("\n\t\tWHERE %s >= $%d", score, argIndex)
 }
 
-func (r *ruleRepository) UpdateEmbedding(ctx context.Context, id int64, embedding []float32) error {
+// hybridSearchQuery builds the query combining vector similarity with full-text rank.
+// Candidates are the nearest chunks by vector plus the best chunks by text rank; every
+// candidate is scored on both sides and each rule keeps its best chunk.
+func hybridSearchQuery(search *domain.SimilaritySearch, candidates int) (string, []interface{}, error) {
+	dimensions := len(search.Embedding)
+	distance := vectorDistance(search.Metric, dimensions)
+	args := []interface{}{pgvector.NewVector(search.Embedding)}
+	filter, args, err := searchFilter(search, args)
+	if err != nil {
+		return "", nil, err
+	}
+	argIndex := len(args) + 1
+
+	textIndex, alphaIndex, candidatesIndex, limitIndex := argIndex, argIndex+1, argIndex+2, argIndex+3
+	args = append(args, search.Text, search.Alpha, candidates, search.Limit)
+
+	// Text rank is normalized to 0..1 (rank / (rank + 1)) to be comparable with similarity
+	score := fmt.Sprintf("$%[1]d * vector_score + (1 - $%[1]d) * text_score", alphaIndex)
+	if search.Mode == domain.SearchModeHybridRRF {
+		score = fmt.Sprintf("$%[1]d / (%[2]d + vector_rank)"+
+			" + CASE WHEN text_score > 0 THEN (1 - $%[1]d) / (%[2]d + text_rank) ELSE 0 END", alphaIndex, hybridRRFK)
+	}
+
+	query := fmt.Sprintf(`
+		WITH q AS (
+			SELECT `+textSearchQuery+` AS query
+		), vector_hits AS (
+			SELECT c.rule_id, c.chunk_index
+			FROM rule_embedding_chunks c
+			JOIN rules r ON r.id = c.rule_id
+			JOIN rule_types rt ON r.rule_type_id = rt.id
+			WHERE %[16]s AND c.dimensions = %[2]d%[3]s
+			ORDER BY %[12]s
+			LIMIT $%[4]d
+		), text_hits AS (
+			SELECT c.rule_id, c.chunk_index
+			FROM rule_embedding_chunks c
+			JOIN rules r ON r.id = c.rule_id
+			JOIN rule_types rt ON r.rule_type_id = rt.id
+			CROSS JOIN q
+			WHERE %[16]s AND c.dimensions = %[2]d AND c.search_vector @@ q.query%[3]s
+			ORDER BY ts_rank(c.search_vector, q.query, 32) DESC
+			LIMIT $%[4]d
+		), candidates AS (
+			SELECT c.rule_id, c.chunk_index, c.content AS chunk_text,
+			       %[11]s AS vector_score,
+			       ts_rank(c.search_vector, q.query, 32) AS text_score%[13]s
+			FROM rule_embedding_chunks c
+			CROSS JOIN q
+			WHERE %[16]s AND (c.rule_id, c.chunk_index) IN (
+				SELECT rule_id, chunk_index FROM vector_hits
+				UNION
+				SELECT rule_id, chunk_index FROM text_hits
+			)
+		), best AS (
+			SELECT DISTINCT ON (rule_id) rule_id, chunk_index, chunk_text, vector_score, text_score%[14]s
+			FROM candidates
+			ORDER BY rule_id, $%[5]d * vector_score + (1 - $%[5]d) * text_score DESC
+		), ranked AS (
+			SELECT rule_id, chunk_index, chunk_text, vector_score, text_score%[14]s,
+			       ROW_NUMBER() OVER (ORDER BY vector_score DESC) AS vector_rank,
+			       ROW_NUMBER() OVER (ORDER BY text_score DESC) AS text_rank
+			FROM best
+		)
+		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
+		       rt.name as rule_type_name,
+		       %[6]s AS score, b.chunk_index, COALESCE(b.chunk_text, '')%[15]s%[9]s,
+		       GREATEST((SELECT count(*) FROM vector_hits), (SELECT count(*) FROM text_hits)) AS candidate_chunks
+		FROM ranked b
+		JOIN rules r ON r.id = b.rule_id
+		JOIN rule_types rt ON r.rule_type_id = rt.id%[10]s%[8]s
+		ORDER BY score DESC
+		LIMIT $%[7]d`, textIndex, dimensions, filter, candidatesIndex, alphaIndex, score, limitIndex,
+		minScoreCondition(score, search.MinScore, limitIndex+1), embeddingColumn(search), embeddingJoin(search),
+		vectorSimilarity(search.Metric, distance), distance,
+		chunkEmbeddingColumn(search, "c.embedding AS chunk_embedding"), chunkEmbeddingColumn(search, "chunk_embedding"),
+		chunkEmbeddingColumn(search, "b.chunk_embedding"), modelCondition("c.model", search.Model))
+	if search.MinScore != nil {
+		args = append(args, *search.MinScore)
+	}
+
+	return query, args, nil
+}
+
+func (r *ruleRepository) UpdateEmbedding(ctx context.Context, id int64, embedding *domain.RuleEmbedding) error {
 	const query = `
-		UPDATE rules 
-		SET embedding = $2, updated_at = NOW()
-		WHERE id = $1`
+		INSERT INTO rule_embeddings (rule_id, model, dimensions, embedding)
+		SELECT id, $2, $3, $4 FROM rules WHERE id = $1
+		ON CONFLICT (rule_id, model)
+		DO UPDATE SET dimensions = EXCLUDED.dimensions, embedding = EXCLUDED.embedding, created_at = NOW()`
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
-	result, err := r.db.Exec(ctx, query, id, pgvector.NewVector(embedding))
+	result, err := tx.Exec(ctx, query, id, embedding.Model, len(embedding.Vector), pgvector.NewVector(embedding.Vector))
 	if err != nil {
 		return fmt.Errorf("failed to update rule embedding: %w", err)
 	}
@@ -236,5 +629,218 @@ func (r *ruleRepository) UpdateEmbedding(ctx context.Context, id int64, embeddin
 		return domain.ErrRuleNotFound
 	}
 
+	_, err = tx.Exec(ctx, `DELETE FROM rule_embedding_chunks WHERE rule_id = $1 AND model = $2`, id, embedding.Model)
+	if err != nil {
+		return fmt.Errorf("failed to delete rule embedding chunks: %w", err)
+	}
+
+	if err := insertChunks(ctx, tx, id, embedding); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit rule embedding update: %w", err)
+	}
+
 	return nil
-}
\ No newline at end of file
+}
+
+func (r *ruleRepository) ListForReindex(ctx context.Context, filter *domain.ReindexFilter, afterID int64, limit int) ([]*domain.Rule, error) {
+	where, args := reindexCondition(filter)
+	query := fmt.Sprintf(`
+		SELECT r.id, r.rule_type_id, r.content, r.created_at, r.updated_at,
+		       rt.name as rule_type_name
+		FROM rules r
+		JOIN rule_types rt ON r.rule_type_id = rt.id
+		WHERE %s AND r.id > $%d
+		ORDER BY r.id
+		LIMIT $%d`, where, len(args)+1, len(args)+2)
+	args = append(args, afterID, limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules for reindex: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*domain.Rule
+	for rows.Next() {
+		var rule domain.Rule
+		err := rows.Scan(
+			&rule.ID,
+			&rule.RuleTypeID,
+			&rule.Content,
+			&rule.CreatedAt,
+			&rule.UpdatedAt,
+			&rule.RuleTypeName,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rule: %w", err)
+		}
+		rules = append(rules, &rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *ruleRepository) CountForReindex(ctx context.Context, filter *domain.ReindexFilter) (int, error) {
+	where, args := reindexCondition(filter)
+	query := `
+		SELECT COUNT(*)
+		FROM rules r
+		JOIN rule_types rt ON r.rule_type_id = rt.id
+		WHERE ` + where
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count rules for reindex: %w", err)
+	}
+
+	return count, nil
+}
+
+// reindexCondition builds the WHERE condition selecting rules for a reindex filter
+func reindexCondition(filter *domain.ReindexFilter) (string, []interface{}) {
+	conditions := []string{"TRUE"}
+	var args []interface{}
+
+	if filter.RuleType != nil {
+		args = append(args, *filter.RuleType)
+		conditions = append(conditions, fmt.Sprintf("rt.name = $%d", len(args)))
+	}
+
+	switch filter.Scope {
+	case domain.ReindexScopeMissing:
+		args = append(args, filter.Model)
+		conditions = append(conditions, fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM rule_embeddings e WHERE e.rule_id = r.id AND e.model = $%d)", len(args)))
+	case domain.ReindexScopeStale:
+		// An embedding is stale once the rule or the embedding template of its type changed
+		args = append(args, filter.Model)
+		conditions = append(conditions, fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM rule_embeddings e WHERE e.rule_id = r.id AND e.model = $%d"+
+				" AND e.created_at >= GREATEST(r.updated_at, rt.embedding_template_updated_at))", len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// getEmbeddings loads all embeddings of a rule
+func (r *ruleRepository) getEmbeddings(ctx context.Context, ruleID int64) ([]domain.RuleEmbedding, error) {
+	const query = `
+		SELECT model, dimensions, embedding, created_at
+		FROM rule_embeddings
+		WHERE rule_id = $1
+		ORDER BY model`
+
+	rows, err := r.db.Query(ctx, query, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var embeddings []domain.RuleEmbedding
+	for rows.Next() {
+		var embedding domain.RuleEmbedding
+		var vectorStr string
+		if err := rows.Scan(&embedding.Model, &embedding.Dimensions, &vectorStr, &embedding.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rule embedding: %w", err)
+		}
+
+		var vector pgvector.Vector
+		if err := vector.Scan(vectorStr); err != nil {
+			return nil, fmt.Errorf("failed to parse rule embedding: %w", err)
+		}
+		embedding.Vector = vector.Slice()
+		embeddings = append(embeddings, embedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rule embeddings: %w", err)
+	}
+
+	if err := r.loadChunks(ctx, ruleID, embeddings); err != nil {
+		return nil, err
+	}
+
+	return embeddings, nil
+}
+
+// loadChunks loads chunk texts of rule embeddings; chunk vectors are not loaded
+func (r *ruleRepository) loadChunks(ctx context.Context, ruleID int64, embeddings []domain.RuleEmbedding) error {
+	const query = `
+		SELECT model, chunk_index, COALESCE(content, '')
+		FROM rule_embedding_chunks
+		WHERE rule_id = $1
+		ORDER BY model, chunk_index`
+
+	rows, err := r.db.Query(ctx, query, ruleID)
+	if err != nil {
+		return fmt.Errorf("failed to get rule embedding chunks: %w", err)
+	}
+	defer rows.Close()
+
+	byModel := make(map[string]*domain.RuleEmbedding, len(embeddings))
+	for i := range embeddings {
+		byModel[embeddings[i].Model] = &embeddings[i]
+	}
+
+	for rows.Next() {
+		var model string
+		var chunk domain.RuleChunk
+		if err := rows.Scan(&model, &chunk.Index, &chunk.Text); err != nil {
+			return fmt.Errorf("failed to scan rule embedding chunk: %w", err)
+		}
+		if embedding, ok := byModel[model]; ok {
+			embedding.Chunks = append(embedding.Chunks, chunk)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating rule embedding chunks: %w", err)
+	}
+
+	return nil
+}
+
+// insertEmbeddings stores rule embeddings within a transaction
+func insertEmbeddings(ctx context.Context, tx pgx.Tx, ruleID int64, embeddings []domain.RuleEmbedding) error {
+	const query = `
+		INSERT INTO rule_embeddings (rule_id, model, dimensions, embedding)
+		VALUES ($1, $2, $3, $4)`
+
+	for i := range embeddings {
+		embedding := &embeddings[i]
+		_, err := tx.Exec(ctx, query, ruleID, embedding.Model, len(embedding.Vector), pgvector.NewVector(embedding.Vector))
+		if err != nil {
+			return fmt.Errorf("failed to store rule embedding for model %s: %w", embedding.Model, err)
+		}
+		if err := insertChunks(ctx, tx, ruleID, embedding); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertChunks stores chunk vectors of a rule embedding within a transaction
+func insertChunks(ctx context.Context, tx pgx.Tx, ruleID int64, embedding *domain.RuleEmbedding) error {
+	const query = `
+		INSERT INTO rule_embedding_chunks (rule_id, model, chunk_index, content, dimensions, embedding)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	batch := &pgx.Batch{}
+	for _, chunk := range embedding.Chunks {
+		batch.Queue(query, ruleID, embedding.Model, chunk.Index, chunk.Text, len(chunk.Vector), pgvector.NewVector(chunk.Vector))
+	}
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to store rule embedding chunks for model %s: %w", embedding.Model, err)
+	}
+
+	return nil
+}
@@ -20,15 +20,17 @@ func NewRuleTypeRepository(db *pgxpool.Pool) domain.RuleTypeRepository {
 
 func (r *ruleTypeRepository) Create(ctx context.Context, ruleType *domain.RuleType) (*domain.RuleType, error) {
 	const query = `
-		INSERT INTO rule_types (name)
-		VALUES ($1)
-		RETURNING id, created_at, updated_at`
+		INSERT INTO rule_types (name, embedding_fields, embedding_template)
+		VALUES ($1, $2, $3)
+		RETURNING id, embedding_template_updated_at, created_at, updated_at`
 
 	var result domain.RuleType
 	result.Name = ruleType.Name
+	result.EmbeddingFields = ruleType.EmbeddingFields
+	result.EmbeddingTemplate = ruleType.EmbeddingTemplate
 
-	err := r.db.QueryRow(ctx, query, ruleType.Name).
-		Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	err := r.db.QueryRow(ctx, query, ruleType.Name, ruleType.EmbeddingFields, ruleType.EmbeddingTemplate).
+		Scan(&result.ID, &result.EmbeddingTemplateUpdatedAt, &result.CreatedAt, &result.UpdatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rule type: %w", err)
 	}
@@ -38,7 +40,7 @@ func (r *ruleTypeRepository) Create(ctx context.Context, ruleType *domain.RuleTy
 
 func (r *ruleTypeRepository) GetByID(ctx context.Context, id int64) (*domain.RuleType, error) {
 	const query = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, embedding_fields, embedding_template, embedding_template_updated_at, created_at, updated_at
 		FROM rule_types
 		WHERE id = $1`
 
@@ -46,6 +48,9 @@ func (r *ruleTypeRepository) GetByID(ctx context.Context, id int64) (*domain.Rul
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&ruleType.ID,
 		&ruleType.Name,
+		&ruleType.EmbeddingFields,
+		&ruleType.EmbeddingTemplate,
+		&ruleType.EmbeddingTemplateUpdatedAt,
 		&ruleType.CreatedAt,
 		&ruleType.UpdatedAt,
 	)
@@ -61,7 +66,7 @@ func (r *ruleTypeRepository) GetByID(ctx context.Context, id int64) (*domain.Rul
 
 func (r *ruleTypeRepository) GetByName(ctx context.Context, name string) (*domain.RuleType, error) {
 	const query = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, embedding_fields, embedding_template, embedding_template_updated_at, created_at, updated_at
 		FROM rule_types
 		WHERE name = $1`
 
@@ -69,6 +74,9 @@ func (r *ruleTypeRepository) GetByName(ctx context.Context, name string) (*domai
 	err := r.db.QueryRow(ctx, query, name).Scan(
 		&ruleType.ID,
 		&ruleType.Name,
+		&ruleType.EmbeddingFields,
+		&ruleType.EmbeddingTemplate,
+		&ruleType.EmbeddingTemplateUpdatedAt,
 		&ruleType.CreatedAt,
 		&ruleType.UpdatedAt,
 	)
@@ -85,12 +93,17 @@ func (r *ruleTypeRepository) GetByName(ctx context.Context, name string) (*domai
 func (r *ruleTypeRepository) Update(ctx context.Context, ruleType *domain.RuleType) (*domain.RuleType, error) {
 	const query = `
 		UPDATE rule_types 
-		SET name = $2, updated_at = NOW()
+		SET name = $2, embedding_fields = $3, embedding_template = $4,
+		    embedding_template_updated_at = CASE
+		        WHEN embedding_fields IS DISTINCT FROM $3 OR embedding_template IS DISTINCT FROM $4 THEN NOW()
+		        ELSE embedding_template_updated_at
+		    END,
+		    updated_at = NOW()
 		WHERE id = $1
-		RETURNING updated_at`
+		RETURNING embedding_template_updated_at, updated_at`
 
-	err := r.db.QueryRow(ctx, query, ruleType.ID, ruleType.Name).
-		Scan(&ruleType.UpdatedAt)
+	err := r.db.QueryRow(ctx, query, ruleType.ID, ruleType.Name, ruleType.EmbeddingFields, ruleType.EmbeddingTemplate).
+		Scan(&ruleType.EmbeddingTemplateUpdatedAt, &ruleType.UpdatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, domain.ErrRuleTypeNotFound
@@ -118,7 +131,7 @@ func (r *ruleTypeRepository) Delete(ctx context.Context, id int64) error {
 
 func (r *ruleTypeRepository) List(ctx context.Context, limit, offset int) ([]*domain.RuleType, error) {
 	const query = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, embedding_fields, embedding_template, embedding_template_updated_at, created_at, updated_at
 		FROM rule_types
 		ORDER BY name ASC
 		LIMIT $1 OFFSET $2`
@@ -135,6 +148,9 @@ func (r *ruleTypeRepository) List(ctx context.Context, limit, offset int) ([]*do
 		err := rows.Scan(
 			&ruleType.ID,
 			&ruleType.Name,
+			&ruleType.EmbeddingFields,
+			&ruleType.EmbeddingTemplate,
+			&ruleType.EmbeddingTemplateUpdatedAt,
 			&ruleType.CreatedAt,
 			&ruleType.UpdatedAt,
 		)
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// maxIdentifierLength is the PostgreSQL limit of identifier length in bytes
+const maxIdentifierLength = 63
+
+// vectorIndexLockKey is the advisory lock serializing index builds of service instances
+const vectorIndexLockKey = 7301001
+
+var indexNameUnsafe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// EnsureVectorIndex creates the hnsw index of chunks of the model, size and metric unless a valid one exists.
+// Indexes created in init-db count as well, whatever their name. The index is built concurrently, so
+// writes go on meanwhile; a build interrupted before is dropped and started over. Instances starting
+// together build one at a time, so that none drops an index another one is building.
+func (r *ruleRepository) EnsureVectorIndex(ctx context.Context, model string, dimensions int, metric domain.DistanceMetric) (bool, error) {
+	if dimensions <= 0 {
+		return false, fmt.Errorf("%w: vector index dimensions must be positive", domain.ErrInvalidInput)
+	}
+
+	// Session advisory locks belong to a connection, so every statement runs on the same one
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", vectorIndexLockKey); err != nil {
+		return false, fmt.Errorf("failed to lock vector index builds: %w", err)
+	}
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", vectorIndexLockKey)
+
+	// pg_get_indexdef prints the expression, operator class and predicate of the index
+	const existsQuery = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM pg_index i
+			WHERE i.indrelid = 'rule_embedding_chunks'::regclass AND i.indisvalid
+			  AND strpos(pg_get_indexdef(i.indexrelid), $1) > 0
+			  AND strpos(pg_get_indexdef(i.indexrelid), $2) > 0
+		)`
+
+	operatorClass := vectorOperatorClass(metric)
+	expression := fmt.Sprintf("::vector(%d)) %s", dimensions, operatorClass)
+	predicate := "(" + modelCondition("model", model) + "::text)"
+
+	var exists bool
+	if err := conn.QueryRow(ctx, existsQuery, expression, predicate).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check vector index: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	// CONCURRENTLY cannot run in a transaction block, the statements are sent one by one
+	name := vectorIndexName(model, dimensions, operatorClass)
+	if _, err := conn.Exec(ctx, "DROP INDEX CONCURRENTLY IF EXISTS "+name); err != nil {
+		return false, fmt.Errorf("failed to drop invalid vector index %s: %w", name, err)
+	}
+	create := fmt.Sprintf("CREATE INDEX CONCURRENTLY %s ON rule_embedding_chunks USING hnsw ((embedding::vector(%d)) %s) WHERE %s",
+		name, dimensions, operatorClass, modelCondition("model", model))
+	if _, err := conn.Exec(ctx, create); err != nil {
+		return false, fmt.Errorf("failed to create vector index %s: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// vectorIndexName derives the name of an index created by EnsureVectorIndex. A hash of the index
+// definition keeps names of models differing only in punctuation or long prefixes apart.
+func vectorIndexName(model string, dimensions int, operatorClass string) string {
+	hash := fnv.New32a()
+	fmt.Fprintf(hash, "%s/%d/%s", model, dimensions, operatorClass)
+	suffix := fmt.Sprintf("_%08x", hash.Sum32())
+
+	name := "idx_rule_embedding_chunks_" + strings.Trim(indexNameUnsafe.ReplaceAllString(strings.ToLower(model), "_"), "_")
+	if len(name) > maxIdentifierLength-len(suffix) {
+		name = name[:maxIdentifierLength-len(suffix)]
+	}
+	return name + suffix
+}
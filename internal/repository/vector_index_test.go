@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVectorIndexName(t *testing.T) {
+	name := vectorIndexName("text-embedding-3-small", 1536, "vector_l2_ops")
+	if !strings.HasPrefix(name, "idx_rule_embedding_chunks_text_embedding_3_small_") {
+		t.Errorf("vectorIndexName() = %s", name)
+	}
+
+	long := vectorIndexName(strings.Repeat("very-long-model-name/", 5), 1024, "vector_cosine_ops")
+	if len(long) > maxIdentifierLength {
+		t.Errorf("vectorIndexName() = %s, longer than %d bytes", long, maxIdentifierLength)
+	}
+
+	// Names differing in punctuation only, size or metric get separate indexes
+	names := map[string]bool{
+		vectorIndexName("org/model", 768, "vector_cosine_ops"):  true,
+		vectorIndexName("org-model", 768, "vector_cosine_ops"):  true,
+		vectorIndexName("org-model", 1024, "vector_cosine_ops"): true,
+		vectorIndexName("org-model", 768, "vector_ip_ops"):      true,
+	}
+	if len(names) != 4 {
+		t.Errorf("vectorIndexName() collides: %v", names)
+	}
+}
@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
+)
+
+// timestampLayout is the format of timestamps in protobuf messages
+const timestampLayout = "2006-01-02T15:04:05Z"
+
+// formatTimestamp formats a timestamp for a protobuf message
+func formatTimestamp(t time.Time) string {
+	return t.Format(timestampLayout)
+}
+
+// toProtoContent converts JSON rule content to a protobuf Struct
+func toProtoContent(content json.RawMessage) (*structpb.Struct, error) {
+	var contentMap map[string]interface{}
+	if err := json.Unmarshal(content, &contentMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rule content: %w", err)
+	}
+
+	contentStruct, err := structpb.NewStruct(contentMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create protobuf struct: %w", err)
+	}
+
+	return contentStruct, nil
+}
+
+// fromProtoContent converts a protobuf Struct to JSON rule content
+func fromProtoContent(content *structpb.Struct) (json.RawMessage, error) {
+	if content == nil {
+		return nil, fmt.Errorf("%w: content is required", domain.ErrInvalidInput)
+	}
+
+	data, err := json.Marshal(content.AsMap())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid content: %v", domain.ErrInvalidInput, err)
+	}
+
+	return data, nil
+}
+
+// fromProtoFilter converts a protobuf metadata filter to the domain filter; nil means no filter
+func fromProtoFilter(filter *pb.MetadataFilter) (*domain.MetadataFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	result := &domain.MetadataFilter{
+		Op:   domain.FilterOperator(filter.Op),
+		Path: filter.Path,
+	}
+
+	var err error
+	for _, field := range []struct {
+		target *json.RawMessage
+		value  *structpb.Value
+	}{
+		{&result.Value, filter.Value},
+		{&result.Gt, filter.Gt},
+		{&result.Gte, filter.Gte},
+		{&result.Lt, filter.Lt},
+		{&result.Lte, filter.Lte},
+	} {
+		if *field.target, err = fromProtoValue(field.value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, value := range filter.Values {
+		encoded, err := fromProtoValue(value)
+		if err != nil {
+			return nil, err
+		}
+		result.Values = append(result.Values, encoded)
+	}
+
+	for _, nested := range filter.Filters {
+		converted, err := fromProtoFilter(nested)
+		if err != nil {
+			return nil, err
+		}
+		if converted != nil {
+			result.Filters = append(result.Filters, *converted)
+		}
+	}
+
+	return result, nil
+}
+
+// toProtoFilter converts a domain metadata filter to its protobuf representation
+func toProtoFilter(filter *domain.MetadataFilter) (*pb.MetadataFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	result := &pb.MetadataFilter{
+		Op:   string(filter.Op),
+		Path: filter.Path,
+	}
+
+	var err error
+	for _, field := range []struct {
+		target **structpb.Value
+		value  json.RawMessage
+	}{
+		{&result.Value, filter.Value},
+		{&result.Gt, filter.Gt},
+		{&result.Gte, filter.Gte},
+		{&result.Lt, filter.Lt},
+		{&result.Lte, filter.Lte},
+	} {
+		if *field.target, err = toProtoValue(field.value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, value := range filter.Values {
+		converted, err := toProtoValue(value)
+		if err != nil {
+			return nil, err
+		}
+		result.Values = append(result.Values, converted)
+	}
+
+	for i := range filter.Filters {
+		converted, err := toProtoFilter(&filter.Filters[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Filters = append(result.Filters, converted)
+	}
+
+	return result, nil
+}
+
+// toProtoValue converts JSON to a protobuf value; an empty value stays unset
+func toProtoValue(data json.RawMessage) (*structpb.Value, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return structpb.NewValue(value)
+}
+
+// toProtoExplanation converts a match explanation to its protobuf representation
+func toProtoExplanation(explanation *domain.MatchExplanation) (*pb.MatchExplanation, error) {
+	result := &pb.MatchExplanation{
+		QuerySimilarities: explanation.QuerySimilarities,
+		Distance:          explanation.Distance,
+		Metric:            string(explanation.Metric),
+		RetrievalRank:     int32(explanation.RetrievalRank),
+		FinalRank:         int32(explanation.FinalRank),
+		Plan:              explanation.Plan,
+	}
+
+	if filters := explanation.Filters; filters != nil {
+		result.Filters = &pb.SearchFilters{
+			MinScore: filters.MinScore,
+		}
+		if filters.Types != nil {
+			result.Filters.Types = filters.Types.Include
+			result.Filters.TypeIds = filters.Types.IncludeIDs
+			result.Filters.ExcludeTypes = filters.Types.Exclude
+			result.Filters.ExcludeTypeIds = filters.Types.ExcludeIDs
+		}
+		if len(filters.TypeQuotas) > 0 {
+			result.Filters.TypeQuotas = make(map[string]int32, len(filters.TypeQuotas))
+			for name, quota := range filters.TypeQuotas {
+				result.Filters.TypeQuotas[name] = int32(quota)
+			}
+		}
+
+		var err error
+		if result.Filters.Metadata, err = toProtoFilter(filters.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// fromProtoValue converts a protobuf value to JSON; an unset value stays empty
+func fromProtoValue(value *structpb.Value) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value.AsInterface())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid filter value: %v", domain.ErrInvalidInput, err)
+	}
+
+	return data, nil
+}
+
+// toProtoRule converts a domain rule to its protobuf representation
+func toProtoRule(rule *domain.Rule) (*pb.Rule, error) {
+	content, err := toProtoContent(rule.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.Rule{
+		Id:         rule.ID,
+		RuleTypeId: rule.RuleTypeID,
+		Content:    content,
+		Embeddings: make([]*pb.RuleEmbedding, len(rule.Embeddings)),
+		CreatedAt:  formatTimestamp(rule.CreatedAt),
+		UpdatedAt:  formatTimestamp(rule.UpdatedAt),
+	}
+
+	if rule.RuleTypeName != nil {
+		result.Type = *rule.RuleTypeName
+	}
+
+	for i, embedding := range rule.Embeddings {
+		result.Embeddings[i] = &pb.RuleEmbedding{
+			Model:      embedding.Model,
+			Dimensions: int32(embedding.Dimensions),
+			Chunks:     int32(len(embedding.Chunks)),
+			CreatedAt:  formatTimestamp(embedding.CreatedAt),
+		}
+	}
+
+	for _, duplicate := range rule.PossibleDuplicates {
+		match, err := toProtoRuleMatch(duplicate)
+		if err != nil {
+			return nil, err
+		}
+		result.PossibleDuplicates = append(result.PossibleDuplicates, match)
+	}
+
+	return result, nil
+}
+
+// toProtoRuleType converts a domain rule type to its protobuf representation
+func toProtoRuleType(ruleType *domain.RuleType) *pb.RuleType {
+	return &pb.RuleType{
+		Id:                         ruleType.ID,
+		Name:                       ruleType.Name,
+		EmbeddingFields:            ruleType.EmbeddingFields,
+		EmbeddingTemplate:          ruleType.EmbeddingTemplate,
+		EmbeddingTemplateUpdatedAt: formatTimestamp(ruleType.EmbeddingTemplateUpdatedAt),
+		CreatedAt:                  formatTimestamp(ruleType.CreatedAt),
+		UpdatedAt:                  formatTimestamp(ruleType.UpdatedAt),
+	}
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
+)
+
+// toStatusError converts a domain error into a gRPC status error with a matching code
+func toStatusError(err error, message string) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		code = codes.InvalidArgument
+	case errors.Is(err, domain.ErrRuleNotFound),
+		errors.Is(err, domain.ErrRuleTypeNotFound),
+		errors.Is(err, domain.ErrReindexJobNotFound):
+		code = codes.NotFound
+	case errors.Is(err, domain.ErrDuplicateEntry):
+		code = codes.AlreadyExists
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+
+	st := status.Newf(code, "%s: %v", message, err)
+
+	// Rules rejected as near-duplicates carry the existing rules as details
+	var duplicateErr *domain.DuplicateRulesError
+	if errors.As(err, &duplicateErr) {
+		if details, convErr := toProtoDuplicateRules(duplicateErr); convErr == nil {
+			if withDetails, detailsErr := st.WithDetails(details); detailsErr == nil {
+				st = withDetails
+			}
+		}
+	}
+
+	return st.Err()
+}
+
+// toProtoDuplicateRules converts a near-duplicate error to its protobuf status details
+func toProtoDuplicateRules(err *domain.DuplicateRulesError) (*pb.DuplicateRules, error) {
+	details := &pb.DuplicateRules{
+		Duplicates: make([]*pb.RuleMatch, len(err.Duplicates)),
+	}
+	for i, duplicate := range err.Duplicates {
+		match, convErr := toProtoRuleMatch(duplicate)
+		if convErr != nil {
+			return nil, convErr
+		}
+		details.Duplicates[i] = match
+	}
+	return details, nil
+}
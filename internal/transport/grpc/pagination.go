@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+
+	pageTokenPrefix = "offset:"
+)
+
+// pageSize returns the requested page size clamped to the allowed range
+func pageSize(requested int32) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// encodePageToken builds an opaque token pointing at the given offset
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix + strconv.Itoa(offset)))
+}
+
+// decodePageToken returns the offset a page token points at; an empty token starts from the beginning
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(data), pageTokenPrefix) {
+		return 0, fmt.Errorf("%w: invalid page token", domain.ErrInvalidInput)
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(data), pageTokenPrefix))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: invalid page token", domain.ErrInvalidInput)
+	}
+
+	return offset, nil
+}
+
+// nextPageToken returns the token of the page after the current one, or an empty token if
+// fetched holds no more than size items. Pages are fetched with one extra item to detect the end.
+func nextPageToken(offset, size, fetched int) string {
+	if fetched <= size {
+		return ""
+	}
+	return encodePageToken(offset + size)
+}
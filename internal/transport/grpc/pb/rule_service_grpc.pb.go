@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rule_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RuleRetrievalService_Retrieve_FullMethodName             = "/rule.v1.RuleRetrievalService/Retrieve"
+	RuleRetrievalService_RetrieveSimilarRules_FullMethodName = "/rule.v1.RuleRetrievalService/RetrieveSimilarRules"
+	RuleRetrievalService_RetrieveBatch_FullMethodName        = "/rule.v1.RuleRetrievalService/RetrieveBatch"
+	RuleRetrievalService_RetrieveStream_FullMethodName       = "/rule.v1.RuleRetrievalService/RetrieveStream"
+)
+
+// RuleRetrievalServiceClient is the client API for RuleRetrievalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RuleRetrievalServiceClient interface {
+	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// RetrieveSimilarRules finds rules similar to an existing rule by its stored embedding
+	RetrieveSimilarRules(ctx context.Context, in *RetrieveSimilarRulesRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// RetrieveBatch runs independent retrievals in one call, embedding all their queries at once
+	RetrieveBatch(ctx context.Context, in *RetrieveBatchRequest, opts ...grpc.CallOption) (*RetrieveBatchResponse, error)
+	// RetrieveStream sends matches one by one in rank order, followed by a summary.
+	// A single search (average fusion) without rerank, mmr_lambda and type_quotas sends
+	// matches as the database returns them. Those stages and rrf, max and sum fusion
+	// need all candidates, so matches are sent once the final ranking is computed.
+	RetrieveStream(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (RuleRetrievalService_RetrieveStreamClient, error)
+}
+
+type ruleRetrievalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRuleRetrievalServiceClient(cc grpc.ClientConnInterface) RuleRetrievalServiceClient {
+	return &ruleRetrievalServiceClient{cc}
+}
+
+func (c *ruleRetrievalServiceClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error) {
+	out := new(RetrieveResponse)
+	err := c.cc.Invoke(ctx, RuleRetrievalService_Retrieve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleRetrievalServiceClient) RetrieveSimilarRules(ctx context.Context, in *RetrieveSimilarRulesRequest, opts ...grpc.CallOption) (*RetrieveResponse, error) {
+	out := new(RetrieveResponse)
+	err := c.cc.Invoke(ctx, RuleRetrievalService_RetrieveSimilarRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleRetrievalServiceClient) RetrieveBatch(ctx context.Context, in *RetrieveBatchRequest, opts ...grpc.CallOption) (*RetrieveBatchResponse, error) {
+	out := new(RetrieveBatchResponse)
+	err := c.cc.Invoke(ctx, RuleRetrievalService_RetrieveBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleRetrievalServiceClient) RetrieveStream(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (RuleRetrievalService_RetrieveStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuleRetrievalService_ServiceDesc.Streams[0], RuleRetrievalService_RetrieveStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ruleRetrievalServiceRetrieveStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuleRetrievalService_RetrieveStreamClient interface {
+	Recv() (*RetrieveStreamResponse, error)
+	grpc.ClientStream
+}
+
+type ruleRetrievalServiceRetrieveStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ruleRetrievalServiceRetrieveStreamClient) Recv() (*RetrieveStreamResponse, error) {
+	m := new(RetrieveStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RuleRetrievalServiceServer is the server API for RuleRetrievalService service.
+// All implementations must embed UnimplementedRuleRetrievalServiceServer
+// for forward compatibility
+type RuleRetrievalServiceServer interface {
+	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	// RetrieveSimilarRules finds rules similar to an existing rule by its stored embedding
+	RetrieveSimilarRules(context.Context, *RetrieveSimilarRulesRequest) (*RetrieveResponse, error)
+	// RetrieveBatch runs independent retrievals in one call, embedding all their queries at once
+	RetrieveBatch(context.Context, *RetrieveBatchRequest) (*RetrieveBatchResponse, error)
+	// RetrieveStream sends matches one by one in rank order, followed by a summary.
+	// A single search (average fusion) without rerank, mmr_lambda and type_quotas sends
+	// matches as the database returns them. Those stages and rrf, max and sum fusion
+	// need all candidates, so matches are sent once the final ranking is computed.
+	RetrieveStream(*RetrieveRequest, RuleRetrievalService_RetrieveStreamServer) error
+	mustEmbedUnimplementedRuleRetrievalServiceServer()
+}
+
+// UnimplementedRuleRetrievalServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleRetrievalServiceServer struct {
+}
+
+func (UnimplementedRuleRetrievalServiceServer) Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
+}
+func (UnimplementedRuleRetrievalServiceServer) RetrieveSimilarRules(context.Context, *RetrieveSimilarRulesRequest) (*RetrieveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveSimilarRules not implemented")
+}
+func (UnimplementedRuleRetrievalServiceServer) RetrieveBatch(context.Context, *RetrieveBatchRequest) (*RetrieveBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBatch not implemented")
+}
+func (UnimplementedRuleRetrievalServiceServer) RetrieveStream(*RetrieveRequest, RuleRetrievalService_RetrieveStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RetrieveStream not implemented")
+}
+func (UnimplementedRuleRetrievalServiceServer) mustEmbedUnimplementedRuleRetrievalServiceServer() {}
+
+// UnsafeRuleRetrievalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleRetrievalServiceServer will
+// result in compilation errors.
+type UnsafeRuleRetrievalServiceServer interface {
+	mustEmbedUnimplementedRuleRetrievalServiceServer()
+}
+
+func RegisterRuleRetrievalServiceServer(s grpc.ServiceRegistrar, srv RuleRetrievalServiceServer) {
+	s.RegisterService(&RuleRetrievalService_ServiceDesc, srv)
+}
+
+func _RuleRetrievalService_Retrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleRetrievalServiceServer).Retrieve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleRetrievalService_Retrieve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleRetrievalServiceServer).Retrieve(ctx, req.(*RetrieveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleRetrievalService_RetrieveSimilarRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveSimilarRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleRetrievalServiceServer).RetrieveSimilarRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleRetrievalService_RetrieveSimilarRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleRetrievalServiceServer).RetrieveSimilarRules(ctx, req.(*RetrieveSimilarRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleRetrievalService_RetrieveBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleRetrievalServiceServer).RetrieveBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleRetrievalService_RetrieveBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleRetrievalServiceServer).RetrieveBatch(ctx, req.(*RetrieveBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleRetrievalService_RetrieveStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuleRetrievalServiceServer).RetrieveStream(m, &ruleRetrievalServiceRetrieveStreamServer{stream})
+}
+
+type RuleRetrievalService_RetrieveStreamServer interface {
+	Send(*RetrieveStreamResponse) error
+	grpc.ServerStream
+}
+
+type ruleRetrievalServiceRetrieveStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ruleRetrievalServiceRetrieveStreamServer) Send(m *RetrieveStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RuleRetrievalService_ServiceDesc is the grpc.ServiceDesc for RuleRetrievalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RuleRetrievalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rule.v1.RuleRetrievalService",
+	HandlerType: (*RuleRetrievalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Retrieve",
+			Handler:    _RuleRetrievalService_Retrieve_Handler,
+		},
+		{
+			MethodName: "RetrieveSimilarRules",
+			Handler:    _RuleRetrievalService_RetrieveSimilarRules_Handler,
+		},
+		{
+			MethodName: "RetrieveBatch",
+			Handler:    _RuleRetrievalService_RetrieveBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RetrieveStream",
+			Handler:       _RuleRetrievalService_RetrieveStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rule_service.proto",
+}
+
+const (
+	ReindexService_StartReindex_FullMethodName     = "/rule.v1.ReindexService/StartReindex"
+	ReindexService_GetReindexJob_FullMethodName    = "/rule.v1.ReindexService/GetReindexJob"
+	ReindexService_ListReindexJobs_FullMethodName  = "/rule.v1.ReindexService/ListReindexJobs"
+	ReindexService_CancelReindexJob_FullMethodName = "/rule.v1.ReindexService/CancelReindexJob"
+)
+
+// ReindexServiceClient is the client API for ReindexService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReindexServiceClient interface {
+	StartReindex(ctx context.Context, in *StartReindexRequest, opts ...grpc.CallOption) (*ReindexJob, error)
+	GetReindexJob(ctx context.Context, in *GetReindexJobRequest, opts ...grpc.CallOption) (*ReindexJob, error)
+	ListReindexJobs(ctx context.Context, in *ListReindexJobsRequest, opts ...grpc.CallOption) (*ListReindexJobsResponse, error)
+	CancelReindexJob(ctx context.Context, in *CancelReindexJobRequest, opts ...grpc.CallOption) (*ReindexJob, error)
+}
+
+type reindexServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReindexServiceClient(cc grpc.ClientConnInterface) ReindexServiceClient {
+	return &reindexServiceClient{cc}
+}
+
+func (c *reindexServiceClient) StartReindex(ctx context.Context, in *StartReindexRequest, opts ...grpc.CallOption) (*ReindexJob, error) {
+	out := new(ReindexJob)
+	err := c.cc.Invoke(ctx, ReindexService_StartReindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reindexServiceClient) GetReindexJob(ctx context.Context, in *GetReindexJobRequest, opts ...grpc.CallOption) (*ReindexJob, error) {
+	out := new(ReindexJob)
+	err := c.cc.Invoke(ctx, ReindexService_GetReindexJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reindexServiceClient) ListReindexJobs(ctx context.Context, in *ListReindexJobsRequest, opts ...grpc.CallOption) (*ListReindexJobsResponse, error) {
+	out := new(ListReindexJobsResponse)
+	err := c.cc.Invoke(ctx, ReindexService_ListReindexJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reindexServiceClient) CancelReindexJob(ctx context.Context, in *CancelReindexJobRequest, opts ...grpc.CallOption) (*ReindexJob, error) {
+	out := new(ReindexJob)
+	err := c.cc.Invoke(ctx, ReindexService_CancelReindexJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReindexServiceServer is the server API for ReindexService service.
+// All implementations must embed UnimplementedReindexServiceServer
+// for forward compatibility
+type ReindexServiceServer interface {
+	StartReindex(context.Context, *StartReindexRequest) (*ReindexJob, error)
+	GetReindexJob(context.Context, *GetReindexJobRequest) (*ReindexJob, error)
+	ListReindexJobs(context.Context, *ListReindexJobsRequest) (*ListReindexJobsResponse, error)
+	CancelReindexJob(context.Context, *CancelReindexJobRequest) (*ReindexJob, error)
+	mustEmbedUnimplementedReindexServiceServer()
+}
+
+// UnimplementedReindexServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReindexServiceServer struct {
+}
+
+func (UnimplementedReindexServiceServer) StartReindex(context.Context, *StartReindexRequest) (*ReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReindex not implemented")
+}
+func (UnimplementedReindexServiceServer) GetReindexJob(context.Context, *GetReindexJobRequest) (*ReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReindexJob not implemented")
+}
+func (UnimplementedReindexServiceServer) ListReindexJobs(context.Context, *ListReindexJobsRequest) (*ListReindexJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReindexJobs not implemented")
+}
+func (UnimplementedReindexServiceServer) CancelReindexJob(context.Context, *CancelReindexJobRequest) (*ReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReindexJob not implemented")
+}
+func (UnimplementedReindexServiceServer) mustEmbedUnimplementedReindexServiceServer() {}
+
+// UnsafeReindexServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReindexServiceServer will
+// result in compilation errors.
+type UnsafeReindexServiceServer interface {
+	mustEmbedUnimplementedReindexServiceServer()
+}
+
+func RegisterReindexServiceServer(s grpc.ServiceRegistrar, srv ReindexServiceServer) {
+	s.RegisterService(&ReindexService_ServiceDesc, srv)
+}
+
+func _ReindexService_StartReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).StartReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReindexService_StartReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).StartReindex(ctx, req.(*StartReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReindexService_GetReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).GetReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReindexService_GetReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).GetReindexJob(ctx, req.(*GetReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReindexService_ListReindexJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReindexJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).ListReindexJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReindexService_ListReindexJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).ListReindexJobs(ctx, req.(*ListReindexJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReindexService_CancelReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).CancelReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReindexService_CancelReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).CancelReindexJob(ctx, req.(*CancelReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReindexService_ServiceDesc is the grpc.ServiceDesc for ReindexService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReindexService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rule.v1.ReindexService",
+	HandlerType: (*ReindexServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartReindex",
+			Handler:    _ReindexService_StartReindex_Handler,
+		},
+		{
+			MethodName: "GetReindexJob",
+			Handler:    _ReindexService_GetReindexJob_Handler,
+		},
+		{
+			MethodName: "ListReindexJobs",
+			Handler:    _ReindexService_ListReindexJobs_Handler,
+		},
+		{
+			MethodName: "CancelReindexJob",
+			Handler:    _ReindexService_CancelReindexJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rule_service.proto",
+}
+
+const (
+	RuleAdminService_CreateRule_FullMethodName = "/rule.v1.RuleAdminService/CreateRule"
+	RuleAdminService_GetRule_FullMethodName    = "/rule.v1.RuleAdminService/GetRule"
+	RuleAdminService_UpdateRule_FullMethodName = "/rule.v1.RuleAdminService/UpdateRule"
+	RuleAdminService_DeleteRule_FullMethodName = "/rule.v1.RuleAdminService/DeleteRule"
+	RuleAdminService_ListRules_FullMethodName  = "/rule.v1.RuleAdminService/ListRules"
+)
+
+// RuleAdminServiceClient is the client API for RuleAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RuleAdminServiceClient interface {
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*Rule, error)
+	GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*Rule, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*Rule, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+}
+
+type ruleAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRuleAdminServiceClient(cc grpc.ClientConnInterface) RuleAdminServiceClient {
+	return &ruleAdminServiceClient{cc}
+}
+
+func (c *ruleAdminServiceClient) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, RuleAdminService_CreateRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, RuleAdminService_UpdateRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_DeleteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RuleAdminServiceServer is the server API for RuleAdminService service.
+// All implementations must embed UnimplementedRuleAdminServiceServer
+// for forward compatibility
+type RuleAdminServiceServer interface {
+	CreateRule(context.Context, *CreateRuleRequest) (*Rule, error)
+	GetRule(context.Context, *GetRuleRequest) (*Rule, error)
+	UpdateRule(context.Context, *UpdateRuleRequest) (*Rule, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error)
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	mustEmbedUnimplementedRuleAdminServiceServer()
+}
+
+// UnimplementedRuleAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleAdminServiceServer struct {
+}
+
+func (UnimplementedRuleAdminServiceServer) CreateRule(context.Context, *CreateRuleRequest) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetRule(context.Context, *GetRuleRequest) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) UpdateRule(context.Context, *UpdateRuleRequest) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) mustEmbedUnimplementedRuleAdminServiceServer() {}
+
+// UnsafeRuleAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleAdminServiceServer will
+// result in compilation errors.
+type UnsafeRuleAdminServiceServer interface {
+	mustEmbedUnimplementedRuleAdminServiceServer()
+}
+
+func RegisterRuleAdminServiceServer(s grpc.ServiceRegistrar, srv RuleAdminServiceServer) {
+	s.RegisterService(&RuleAdminService_ServiceDesc, srv)
+}
+
+func _RuleAdminService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RuleAdminService_ServiceDesc is the grpc.ServiceDesc for RuleAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RuleAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rule.v1.RuleAdminService",
+	HandlerType: (*RuleAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRule",
+			Handler:    _RuleAdminService_CreateRule_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _RuleAdminService_GetRule_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _RuleAdminService_UpdateRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _RuleAdminService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _RuleAdminService_ListRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rule_service.proto",
+}
+
+const (
+	RuleTypeService_CreateRuleType_FullMethodName = "/rule.v1.RuleTypeService/CreateRuleType"
+	RuleTypeService_GetRuleType_FullMethodName    = "/rule.v1.RuleTypeService/GetRuleType"
+	RuleTypeService_UpdateRuleType_FullMethodName = "/rule.v1.RuleTypeService/UpdateRuleType"
+	RuleTypeService_DeleteRuleType_FullMethodName = "/rule.v1.RuleTypeService/DeleteRuleType"
+	RuleTypeService_ListRuleTypes_FullMethodName  = "/rule.v1.RuleTypeService/ListRuleTypes"
+)
+
+// RuleTypeServiceClient is the client API for RuleTypeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RuleTypeServiceClient interface {
+	CreateRuleType(ctx context.Context, in *CreateRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error)
+	GetRuleType(ctx context.Context, in *GetRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error)
+	UpdateRuleType(ctx context.Context, in *UpdateRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error)
+	DeleteRuleType(ctx context.Context, in *DeleteRuleTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRuleTypes(ctx context.Context, in *ListRuleTypesRequest, opts ...grpc.CallOption) (*ListRuleTypesResponse, error)
+}
+
+type ruleTypeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRuleTypeServiceClient(cc grpc.ClientConnInterface) RuleTypeServiceClient {
+	return &ruleTypeServiceClient{cc}
+}
+
+func (c *ruleTypeServiceClient) CreateRuleType(ctx context.Context, in *CreateRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error) {
+	out := new(RuleType)
+	err := c.cc.Invoke(ctx, RuleTypeService_CreateRuleType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleTypeServiceClient) GetRuleType(ctx context.Context, in *GetRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error) {
+	out := new(RuleType)
+	err := c.cc.Invoke(ctx, RuleTypeService_GetRuleType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleTypeServiceClient) UpdateRuleType(ctx context.Context, in *UpdateRuleTypeRequest, opts ...grpc.CallOption) (*RuleType, error) {
+	out := new(RuleType)
+	err := c.cc.Invoke(ctx, RuleTypeService_UpdateRuleType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleTypeServiceClient) DeleteRuleType(ctx context.Context, in *DeleteRuleTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleTypeService_DeleteRuleType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleTypeServiceClient) ListRuleTypes(ctx context.Context, in *ListRuleTypesRequest, opts ...grpc.CallOption) (*ListRuleTypesResponse, error) {
+	out := new(ListRuleTypesResponse)
+	err := c.cc.Invoke(ctx, RuleTypeService_ListRuleTypes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RuleTypeServiceServer is the server API for RuleTypeService service.
+// All implementations must embed UnimplementedRuleTypeServiceServer
+// for forward compatibility
+type RuleTypeServiceServer interface {
+	CreateRuleType(context.Context, *CreateRuleTypeRequest) (*RuleType, error)
+	GetRuleType(context.Context, *GetRuleTypeRequest) (*RuleType, error)
+	UpdateRuleType(context.Context, *UpdateRuleTypeRequest) (*RuleType, error)
+	DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*emptypb.Empty, error)
+	ListRuleTypes(context.Context, *ListRuleTypesRequest) (*ListRuleTypesResponse, error)
+	mustEmbedUnimplementedRuleTypeServiceServer()
+}
+
+// UnimplementedRuleTypeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleTypeServiceServer struct {
+}
+
+func (UnimplementedRuleTypeServiceServer) CreateRuleType(context.Context, *CreateRuleTypeRequest) (*RuleType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRuleType not implemented")
+}
+func (UnimplementedRuleTypeServiceServer) GetRuleType(context.Context, *GetRuleTypeRequest) (*RuleType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleType not implemented")
+}
+func (UnimplementedRuleTypeServiceServer) UpdateRuleType(context.Context, *UpdateRuleTypeRequest) (*RuleType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuleType not implemented")
+}
+func (UnimplementedRuleTypeServiceServer) DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRuleType not implemented")
+}
+func (UnimplementedRuleTypeServiceServer) ListRuleTypes(context.Context, *ListRuleTypesRequest) (*ListRuleTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleTypes not implemented")
+}
+func (UnimplementedRuleTypeServiceServer) mustEmbedUnimplementedRuleTypeServiceServer() {}
+
+// UnsafeRuleTypeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleTypeServiceServer will
+// result in compilation errors.
+type UnsafeRuleTypeServiceServer interface {
+	mustEmbedUnimplementedRuleTypeServiceServer()
+}
+
+func RegisterRuleTypeServiceServer(s grpc.ServiceRegistrar, srv RuleTypeServiceServer) {
+	s.RegisterService(&RuleTypeService_ServiceDesc, srv)
+}
+
+func _RuleTypeService_CreateRuleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).CreateRuleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_CreateRuleType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).CreateRuleType(ctx, req.(*CreateRuleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleTypeService_GetRuleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).GetRuleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_GetRuleType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).GetRuleType(ctx, req.(*GetRuleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleTypeService_UpdateRuleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).UpdateRuleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_UpdateRuleType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).UpdateRuleType(ctx, req.(*UpdateRuleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleTypeService_DeleteRuleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).DeleteRuleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_DeleteRuleType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).DeleteRuleType(ctx, req.(*DeleteRuleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleTypeService_ListRuleTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleTypeServiceServer).ListRuleTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleTypeService_ListRuleTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleTypeServiceServer).ListRuleTypes(ctx, req.(*ListRuleTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RuleTypeService_ServiceDesc is the grpc.ServiceDesc for RuleTypeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RuleTypeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rule.v1.RuleTypeService",
+	HandlerType: (*RuleTypeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRuleType",
+			Handler:    _RuleTypeService_CreateRuleType_Handler,
+		},
+		{
+			MethodName: "GetRuleType",
+			Handler:    _RuleTypeService_GetRuleType_Handler,
+		},
+		{
+			MethodName: "UpdateRuleType",
+			Handler:    _RuleTypeService_UpdateRuleType_Handler,
+		},
+		{
+			MethodName: "DeleteRuleType",
+			Handler:    _RuleTypeService_DeleteRuleType_Handler,
+		},
+		{
+			MethodName: "ListRuleTypes",
+			Handler:    _RuleTypeService_ListRuleTypes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rule_service.proto",
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
+)
+
+// reindexServer implements the gRPC Reindex service
+type reindexServer struct {
+	pb.UnimplementedReindexServiceServer
+	reindexService domain.ReindexService
+}
+
+// NewReindexServer creates a new gRPC server for reindex jobs
+func NewReindexServer(reindexService domain.ReindexService) pb.ReindexServiceServer {
+	return &reindexServer{
+		reindexService: reindexService,
+	}
+}
+
+// StartReindex starts a background reindex job
+func (s *reindexServer) StartReindex(ctx context.Context, req *pb.StartReindexRequest) (*pb.ReindexJob, error) {
+	job, err := s.reindexService.StartReindex(ctx, &domain.StartReindexRequest{
+		Model:             req.Model,
+		Scope:             domain.ReindexScope(req.Scope),
+		RuleType:          req.RuleType,
+		BatchSize:         int(req.BatchSize),
+		MaxRulesPerSecond: int(req.MaxRulesPerSecond),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to start reindex job")
+	}
+
+	return toProtoReindexJob(job), nil
+}
+
+// GetReindexJob returns the progress of a reindex job
+func (s *reindexServer) GetReindexJob(ctx context.Context, req *pb.GetReindexJobRequest) (*pb.ReindexJob, error) {
+	job, err := s.reindexService.GetReindexJob(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get reindex job")
+	}
+
+	return toProtoReindexJob(job), nil
+}
+
+// ListReindexJobs lists reindex jobs, newest first
+func (s *reindexServer) ListReindexJobs(ctx context.Context, req *pb.ListReindexJobsRequest) (*pb.ListReindexJobsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+	if offset < 0 {
+		offset = 0
+	}
+
+	jobs, err := s.reindexService.ListReindexJobs(ctx, limit, offset)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list reindex jobs")
+	}
+
+	response := &pb.ListReindexJobsResponse{
+		Jobs: make([]*pb.ReindexJob, len(jobs)),
+	}
+	for i, job := range jobs {
+		response.Jobs[i] = toProtoReindexJob(job)
+	}
+
+	return response, nil
+}
+
+// CancelReindexJob cancels a running reindex job
+func (s *reindexServer) CancelReindexJob(ctx context.Context, req *pb.CancelReindexJobRequest) (*pb.ReindexJob, error) {
+	job, err := s.reindexService.CancelReindexJob(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to cancel reindex job")
+	}
+
+	return toProtoReindexJob(job), nil
+}
+
+// toProtoReindexJob converts a domain reindex job to its protobuf representation
+func toProtoReindexJob(job *domain.ReindexJob) *pb.ReindexJob {
+	result := &pb.ReindexJob{
+		Id:                job.ID,
+		Model:             job.Model,
+		Scope:             string(job.Scope),
+		RuleType:          job.RuleType,
+		BatchSize:         int32(job.BatchSize),
+		MaxRulesPerSecond: int32(job.MaxRulesPerSecond),
+		Status:            string(job.Status),
+		Total:             int32(job.Total),
+		Processed:         int32(job.Processed),
+		LastRuleId:        job.LastRuleID,
+		Error:             job.Error,
+		CreatedAt:         formatTimestamp(job.CreatedAt),
+		UpdatedAt:         formatTimestamp(job.UpdatedAt),
+	}
+
+	if job.FinishedAt != nil {
+		finishedAt := formatTimestamp(*job.FinishedAt)
+		result.FinishedAt = &finishedAt
+	}
+
+	return result
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
+)
+
+// ruleAdminServer implements the gRPC RuleAdmin service
+type ruleAdminServer struct {
+	pb.UnimplementedRuleAdminServiceServer
+	ruleService domain.RuleService
+}
+
+// NewRuleAdminServer creates a new gRPC server for managing rules
+func NewRuleAdminServer(ruleService domain.RuleService) pb.RuleAdminServiceServer {
+	return &ruleAdminServer{
+		ruleService: ruleService,
+	}
+}
+
+// CreateRule creates a rule and embeds its content
+func (s *ruleAdminServer) CreateRule(ctx context.Context, req *pb.CreateRuleRequest) (*pb.Rule, error) {
+	if req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+
+	content, err := fromProtoContent(req.Content)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create rule")
+	}
+
+	rule, err := s.ruleService.CreateRule(ctx, &domain.CreateRuleRequest{
+		Type:        req.Type,
+		Content:     content,
+		DedupPolicy: domain.DedupPolicy(req.DedupPolicy),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to create rule")
+	}
+
+	return s.toResponse(rule)
+}
+
+// GetRule returns a rule by ID
+func (s *ruleAdminServer) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb.Rule, error) {
+	rule, err := s.ruleService.GetRule(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get rule")
+	}
+
+	return s.toResponse(rule)
+}
+
+// UpdateRule replaces the type and content of a rule and re-embeds it
+func (s *ruleAdminServer) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.Rule, error) {
+	if req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+
+	content, err := fromProtoContent(req.Content)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update rule")
+	}
+
+	rule, err := s.ruleService.UpdateRule(ctx, &domain.UpdateRuleRequest{
+		ID:          req.Id,
+		Type:        req.Type,
+		Content:     content,
+		DedupPolicy: domain.DedupPolicy(req.DedupPolicy),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to update rule")
+	}
+
+	return s.toResponse(rule)
+}
+
+// DeleteRule deletes a rule by ID
+func (s *ruleAdminServer) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*emptypb.Empty, error) {
+	if err := s.ruleService.DeleteRule(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to delete rule")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListRules lists rules page by page, optionally filtered by type and content
+func (s *ruleAdminServer) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.ListRulesResponse, error) {
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list rules")
+	}
+	size := pageSize(req.PageSize)
+
+	filter, err := fromProtoFilter(req.Filter)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list rules")
+	}
+
+	types := &domain.RuleTypeFilter{
+		Include:    req.Types,
+		IncludeIDs: req.TypeIds,
+		Exclude:    req.ExcludeTypes,
+		ExcludeIDs: req.ExcludeTypeIds,
+	}
+	if req.Type != nil {
+		types.Include = append([]string{*req.Type}, req.Types...)
+	}
+
+	rules, err := s.ruleService.ListRules(ctx, types, filter, size+1, offset)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list rules")
+	}
+
+	response := &pb.ListRulesResponse{
+		NextPageToken: nextPageToken(offset, size, len(rules)),
+	}
+	if len(rules) > size {
+		rules = rules[:size]
+	}
+
+	response.Rules = make([]*pb.Rule, len(rules))
+	for i, rule := range rules {
+		if response.Rules[i], err = s.toResponse(rule); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// toResponse converts a domain rule to a protobuf message
+func (s *ruleAdminServer) toResponse(rule *domain.Rule) (*pb.Rule, error) {
+	result, err := toProtoRule(rule)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert rule: %v", err)
+	}
+	return result, nil
+}
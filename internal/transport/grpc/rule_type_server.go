@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
+)
+
+// ruleTypeServer implements the gRPC RuleType service
+type ruleTypeServer struct {
+	pb.UnimplementedRuleTypeServiceServer
+	ruleTypeService domain.RuleTypeService
+}
+
+// NewRuleTypeServer creates a new gRPC server for managing rule types
+func NewRuleTypeServer(ruleTypeService domain.RuleTypeService) pb.RuleTypeServiceServer {
+	return &ruleTypeServer{
+		ruleTypeService: ruleTypeService,
+	}
+}
+
+// CreateRuleType creates a rule type
+func (s *ruleTypeServer) CreateRuleType(ctx context.Context, req *pb.CreateRuleTypeRequest) (*pb.RuleType, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	ruleType, err := s.ruleTypeService.CreateRuleType(ctx, &domain.CreateRuleTypeRequest{
+		Name:              req.Name,
+		EmbeddingFields:   req.EmbeddingFields,
+		EmbeddingTemplate: req.EmbeddingTemplate,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to create rule type")
+	}
+
+	return toProtoRuleType(ruleType), nil
+}
+
+// GetRuleType returns a rule type by ID
+func (s *ruleTypeServer) GetRuleType(ctx context.Context, req *pb.GetRuleTypeRequest) (*pb.RuleType, error) {
+	ruleType, err := s.ruleTypeService.GetRuleType(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get rule type")
+	}
+
+	return toProtoRuleType(ruleType), nil
+}
+
+// UpdateRuleType updates a rule type, optionally re-embedding its rules
+func (s *ruleTypeServer) UpdateRuleType(ctx context.Context, req *pb.UpdateRuleTypeRequest) (*pb.RuleType, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	ruleType, err := s.ruleTypeService.UpdateRuleType(ctx, &domain.UpdateRuleTypeRequest{
+		ID:                req.Id,
+		Name:              req.Name,
+		EmbeddingFields:   req.EmbeddingFields,
+		EmbeddingTemplate: req.EmbeddingTemplate,
+		Reindex:           req.Reindex,
+
+		ClearEmbeddingFields:   req.ClearEmbeddingFields,
+		ClearEmbeddingTemplate: req.ClearEmbeddingTemplate,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to update rule type")
+	}
+
+	return toProtoRuleType(ruleType), nil
+}
+
+// DeleteRuleType deletes a rule type by ID
+func (s *ruleTypeServer) DeleteRuleType(ctx context.Context, req *pb.DeleteRuleTypeRequest) (*emptypb.Empty, error) {
+	if err := s.ruleTypeService.DeleteRuleType(ctx, req.Id); err != nil {
+		return nil, toStatusError(err, "failed to delete rule type")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListRuleTypes lists rule types page by page
+func (s *ruleTypeServer) ListRuleTypes(ctx context.Context, req *pb.ListRuleTypesRequest) (*pb.ListRuleTypesResponse, error) {
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list rule types")
+	}
+	size := pageSize(req.PageSize)
+
+	ruleTypes, err := s.ruleTypeService.ListRuleTypes(ctx, size+1, offset)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list rule types")
+	}
+
+	response := &pb.ListRuleTypesResponse{
+		NextPageToken: nextPageToken(offset, size, len(ruleTypes)),
+	}
+	if len(ruleTypes) > size {
+		ruleTypes = ruleTypes[:size]
+	}
+
+	response.RuleTypes = make([]*pb.RuleType, len(ruleTypes))
+	for i, ruleType := range ruleTypes {
+		response.RuleTypes[i] = toProtoRuleType(ruleType)
+	}
+
+	return response, nil
+}
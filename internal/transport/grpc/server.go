@@ -2,15 +2,18 @@ package grpc
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"time"
 
-	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ratmirtech/vector-rules-service/internal/domain"
 	"github.com/ratmirtech/vector-rules-service/internal/transport/grpc/pb"
 )
 
+// defaultSimilarRules is the number of similar rules returned when n is not set
+const defaultSimilarRules = 10
+
 // ruleRetrievalServer implements the gRPC RuleRetrieval service
 type ruleRetrievalServer struct {
 	pb.UnimplementedRuleRetrievalServiceServer
@@ -27,61 +30,288 @@ func NewRuleRetrievalServer(ruleService domain.RuleService) pb.RuleRetrievalServ
 // Retrieve implements the gRPC Retrieve method for vector similarity search
 func (s *ruleRetrievalServer) Retrieve(ctx context.Context, req *pb.RetrieveRequest) (*pb.RetrieveResponse, error) {
 	// Validate request
+	if err := validateRetrieveRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Convert to domain query
+	query, err := fromProtoRetrieveRequest(req)
+	if err != nil {
+		return nil, toStatusError(err, "failed to retrieve similar rules")
+	}
+
+	// Call business logic
+	matches, err := s.ruleService.RetrieveSimilar(ctx, query)
+	if err != nil {
+		return nil, toStatusError(err, "failed to retrieve similar rules")
+	}
+
+	return toProtoRetrieveResponse(matches)
+}
+
+// RetrieveStream sends matches as they are ranked, followed by a summary of the retrieval
+func (s *ruleRetrievalServer) RetrieveStream(req *pb.RetrieveRequest, stream pb.RuleRetrievalService_RetrieveStreamServer) error {
+	if err := validateRetrieveRequest(req); err != nil {
+		return err
+	}
+
+	query, err := fromProtoRetrieveRequest(req)
+	if err != nil {
+		return toStatusError(err, "failed to retrieve similar rules")
+	}
+
+	summary, err := s.ruleService.RetrieveStream(stream.Context(), query, func(match *domain.RuleMatch) error {
+		rule, err := toProtoRuleMatch(match)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.RetrieveStreamResponse{
+			Result: &pb.RetrieveStreamResponse_Rule{Rule: rule},
+		})
+	})
+	if err != nil {
+		// Conversion and send failures already carry a status
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return toStatusError(err, "failed to retrieve similar rules")
+	}
+
+	return stream.Send(&pb.RetrieveStreamResponse{
+		Result: &pb.RetrieveStreamResponse_Summary{Summary: toProtoRetrieveSummary(summary)},
+	})
+}
+
+// validateRetrieveRequest checks the required fields of a retrieval request
+func validateRetrieveRequest(req *pb.RetrieveRequest) error {
 	if req.N <= 0 {
-		return nil, fmt.Errorf("n must be greater than 0")
+		return status.Error(codes.InvalidArgument, "n must be greater than 0")
 	}
-	
+
 	if len(req.Queries) == 0 {
-		return nil, fmt.Errorf("queries cannot be empty")
+		return status.Error(codes.InvalidArgument, "queries cannot be empty")
 	}
 
-	// Convert to domain query
+	return nil
+}
+
+// toProtoRetrieveSummary converts a retrieval summary to its protobuf representation
+func toProtoRetrieveSummary(summary *domain.RetrieveSummary) *pb.RetrieveSummary {
+	return &pb.RetrieveSummary{
+		Returned:        int32(summary.Returned),
+		TotalCandidates: int32(summary.TotalCandidates),
+		EmbeddingMs:     milliseconds(summary.EmbeddingTime),
+		SearchMs:        milliseconds(summary.SearchTime),
+		TotalMs:         milliseconds(summary.TotalTime),
+	}
+}
+
+// milliseconds converts a duration to fractional milliseconds
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// RetrieveBatch runs independent retrievals, reporting failures per group
+func (s *ruleRetrievalServer) RetrieveBatch(ctx context.Context, req *pb.RetrieveBatchRequest) (*pb.RetrieveBatchResponse, error) {
+	response := &pb.RetrieveBatchResponse{
+		Results: make([]*pb.RetrieveBatchResult, len(req.Groups)),
+	}
+
+	// Groups that cannot be converted fail alone and are not sent to the service
+	var queries []*domain.RetrieveRulesQuery
+	var indexes []int
+	for i, group := range req.Groups {
+		query, err := fromProtoRetrieveRequest(group)
+		if err != nil {
+			response.Results[i] = toProtoBatchError(err)
+			continue
+		}
+		queries = append(queries, query)
+		indexes = append(indexes, i)
+	}
+
+	if len(queries) > 0 || len(req.Groups) == 0 {
+		results, err := s.ruleService.RetrieveBatch(ctx, queries)
+		if err != nil {
+			return nil, toStatusError(err, "failed to retrieve similar rules")
+		}
+
+		for j, result := range results {
+			i := indexes[j]
+			if result.Err != nil {
+				response.Results[i] = toProtoBatchError(result.Err)
+				continue
+			}
+
+			retrieved, err := toProtoRetrieveResponse(result.Matches)
+			if err != nil {
+				return nil, err
+			}
+			response.Results[i] = &pb.RetrieveBatchResult{Rules: retrieved.Rules}
+		}
+	}
+
+	return response, nil
+}
+
+// toProtoBatchError converts the failure of a batch group to its result
+func toProtoBatchError(err error) *pb.RetrieveBatchResult {
+	st := status.Convert(toStatusError(err, "failed to retrieve similar rules"))
+	return &pb.RetrieveBatchResult{
+		Error:     st.Message(),
+		ErrorCode: st.Code().String(),
+	}
+}
+
+// RetrieveSimilarRules finds rules similar to an existing rule by its stored embedding
+func (s *ruleRetrievalServer) RetrieveSimilarRules(ctx context.Context, req *pb.RetrieveSimilarRulesRequest) (*pb.RetrieveResponse, error) {
+	n := int(req.N)
+	if n == 0 {
+		n = defaultSimilarRules
+	}
+
+	query := &domain.SimilarRulesQuery{
+		RuleID: req.Id,
+		N:      n,
+		Model:  req.Model,
+		Types: &domain.RuleTypeFilter{
+			Include:    req.Types,
+			IncludeIDs: req.TypeIds,
+			Exclude:    req.ExcludeTypes,
+			ExcludeIDs: req.ExcludeTypeIds,
+		},
+	}
+	if req.Metric != nil {
+		query.Metric = domain.DistanceMetric(*req.Metric)
+	}
+
+	matches, err := s.ruleService.FindSimilarRules(ctx, query)
+	if err != nil {
+		return nil, toStatusError(err, "failed to find similar rules")
+	}
+
+	return toProtoRetrieveResponse(matches)
+}
+
+// fromProtoRetrieveRequest converts a retrieval request to a domain query
+func fromProtoRetrieveRequest(req *pb.RetrieveRequest) (*domain.RetrieveRulesQuery, error) {
 	query := &domain.RetrieveRulesQuery{
 		N:       int(req.N),
 		Queries: req.Queries,
 	}
-	
+
 	if req.Type != nil {
 		query.Type = req.Type
 	}
 
-	// Call business logic
-	matches, err := s.ruleService.RetrieveSimilar(ctx, query)
+	if req.Model != nil {
+		query.Model = req.Model
+	}
+
+	if req.Fusion != nil {
+		query.Fusion = domain.FusionStrategy(*req.Fusion)
+	}
+
+	if req.Mode != nil {
+		query.Mode = domain.SearchMode(*req.Mode)
+	}
+
+	if req.Alpha != nil {
+		query.Alpha = req.Alpha
+	}
+
+	if req.Metric != nil {
+		query.Metric = domain.DistanceMetric(*req.Metric)
+	}
+
+	if req.MinScore != nil {
+		query.MinScore = req.MinScore
+	}
+
+	if req.ScoreMode != nil {
+		query.ScoreMode = domain.ScoreMode(*req.ScoreMode)
+	}
+
+	filter, err := fromProtoFilter(req.Filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve similar rules: %w", err)
+		return nil, err
 	}
+	query.Filter = filter
 
-	// Convert domain matches to protobuf response
+	query.Types = req.Types
+	query.TypeIDs = req.TypeIds
+	query.ExcludeTypes = req.ExcludeTypes
+	query.ExcludeTypeIDs = req.ExcludeTypeIds
+
+	if len(req.TypeQuotas) > 0 {
+		query.TypeQuotas = make(map[string]int, len(req.TypeQuotas))
+		for name, quota := range req.TypeQuotas {
+			query.TypeQuotas[name] = int(quota)
+		}
+	}
+
+	if req.MmrLambda != nil {
+		query.MMRLambda = req.MmrLambda
+	}
+
+	query.Rerank = req.Rerank
+	query.Explain = req.Explain
+
+	return query, nil
+}
+
+// toProtoRetrieveResponse converts domain matches to the protobuf response
+func toProtoRetrieveResponse(matches []*domain.RuleMatch) (*pb.RetrieveResponse, error) {
 	response := &pb.RetrieveResponse{
 		Rules: make([]*pb.RuleMatch, len(matches)),
 	}
 
 	for i, match := range matches {
-		// Convert JSON content to protobuf Struct
-		var contentMap map[string]interface{}
-		if err := json.Unmarshal(match.Content, &contentMap); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal rule content: %w", err)
-		}
-
-		contentStruct, err := structpb.NewStruct(contentMap)
+		result, err := toProtoRuleMatch(match)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create protobuf struct: %w", err)
+			return nil, err
 		}
+		response.Rules[i] = result
+	}
 
-		ruleTypeName := ""
-		if match.RuleTypeName != nil {
-			ruleTypeName = *match.RuleTypeName
-		}
+	return response, nil
+}
+
+// toProtoRuleMatch converts a domain match to its protobuf representation
+func toProtoRuleMatch(match *domain.RuleMatch) (*pb.RuleMatch, error) {
+	contentStruct, err := toProtoContent(match.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert rule content: %v", err)
+	}
+
+	ruleTypeName := ""
+	if match.RuleTypeName != nil {
+		ruleTypeName = *match.RuleTypeName
+	}
+
+	result := &pb.RuleMatch{
+		Id:        match.ID,
+		Type:      ruleTypeName,
+		Content:   contentStruct,
+		Score:     match.Score,
+		CreatedAt: formatTimestamp(match.CreatedAt),
+		UpdatedAt: formatTimestamp(match.UpdatedAt),
+		Model:     match.Model,
 
-		response.Rules[i] = &pb.RuleMatch{
-			Id:        match.ID,
-			Type:      ruleTypeName,
-			Content:   contentStruct,
-			Score:     match.Score,
-			CreatedAt: match.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt: match.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		VectorScore: match.VectorScore,
+		RerankScore: match.RerankScore,
+	}
+
+	if match.Chunk != nil {
+		result.ChunkIndex = int32(match.Chunk.Index)
+		result.ChunkText = match.Chunk.Text
+	}
+
+	if match.Explanation != nil {
+		if result.Explanation, err = toProtoExplanation(match.Explanation); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert match explanation: %v", err)
 		}
 	}
 
-	return response, nil
-}
\ No newline at end of file
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// EmbeddingHandler handles HTTP requests for embedding provider diagnostics
+type EmbeddingHandler struct {
+	statsReporter domain.EmbeddingStatsReporter
+}
+
+// NewEmbeddingHandler creates a new embedding handler.
+// statsReporter may be nil when the provider does not expose statistics.
+func NewEmbeddingHandler(statsReporter domain.EmbeddingStatsReporter) *EmbeddingHandler {
+	return &EmbeddingHandler{
+		statsReporter: statsReporter,
+	}
+}
+
+// GetStats returns runtime statistics of the embedding provider chain
+// @Summary Get embedding provider statistics
+// @Description Get cache hit/miss counters and other runtime statistics of the embedding provider
+// @Tags embeddings
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /embeddings/stats [get]
+func (h *EmbeddingHandler) GetStats(c echo.Context) error {
+	stats := map[string]interface{}{}
+	if h.statsReporter != nil {
+		stats = h.statsReporter.EmbeddingStats()
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
@@ -12,44 +12,209 @@ type SwaggerRule struct {
 	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
 	UpdatedAt    time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
 	RuleTypeName *string   `json:"rule_type_name,omitempty" example:"security"`
+
+	Embeddings []SwaggerRuleEmbedding `json:"embeddings,omitempty"`
+}
+
+// SwaggerRuleEmbedding represents rule embedding metadata for Swagger documentation
+type SwaggerRuleEmbedding struct {
+	Model      string             `json:"model" example:"text-embedding-3-small"`
+	Dimensions int                `json:"dimensions" example:"1536"`
+	Chunks     []SwaggerRuleChunk `json:"chunks,omitempty"`
+	CreatedAt  time.Time          `json:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// SwaggerRuleChunk represents a separately embedded part of the rule text for Swagger documentation
+type SwaggerRuleChunk struct {
+	Index int    `json:"index" example:"0"`
+	Text  string `json:"text" example:"Validate email format"`
 }
 
 // SwaggerRuleType represents a rule type for Swagger documentation
 type SwaggerRuleType struct {
-	ID        int64     `json:"id" example:"1"`
-	Name      string    `json:"name" example:"security"`
-	CreatedAt time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
-	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+	ID                         int64     `json:"id" example:"1"`
+	Name                       string    `json:"name" example:"security"`
+	EmbeddingFields            []string  `json:"embedding_fields,omitempty" example:"/description,/tags"`
+	EmbeddingTemplate          *string   `json:"embedding_template,omitempty" example:"{{.description}}. Tags: {{join .tags \", \"}}"`
+	EmbeddingTemplateUpdatedAt time.Time `json:"embedding_template_updated_at" example:"2023-01-01T00:00:00Z"`
+	CreatedAt                  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt                  time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
 }
 
 // SwaggerCreateRuleRequest represents a create rule request for Swagger documentation
 type SwaggerCreateRuleRequest struct {
-	Type    string `json:"type" example:"security" validate:"required"`
-	Content string `json:"content" example:"{\"description\":\"Sample rule content\"}" validate:"required"`
+	Type        string `json:"type" example:"security" validate:"required"`
+	Content     string `json:"content" example:"{\"description\":\"Sample rule content\"}" validate:"required"`
+	DedupPolicy string `json:"dedup_policy,omitempty" example:"reject" enums:"reject,warn,allow"`
 }
 
 // SwaggerUpdateRuleRequest represents an update rule request for Swagger documentation
 type SwaggerUpdateRuleRequest struct {
-	ID      int64  `json:"id" example:"1" validate:"required"`
-	Type    string `json:"type" example:"security" validate:"required"`
-	Content string `json:"content" example:"{\"description\":\"Updated rule content\"}" validate:"required"`
+	ID          int64  `json:"id" example:"1" validate:"required"`
+	Type        string `json:"type" example:"security" validate:"required"`
+	Content     string `json:"content" example:"{\"description\":\"Updated rule content\"}" validate:"required"`
+	DedupPolicy string `json:"dedup_policy,omitempty" example:"reject" enums:"reject,warn,allow"`
 }
 
 // SwaggerCreateRuleTypeRequest represents a create rule type request for Swagger documentation
 type SwaggerCreateRuleTypeRequest struct {
-	Name string `json:"name" example:"security" validate:"required"`
+	Name              string   `json:"name" example:"security" validate:"required"`
+	EmbeddingFields   []string `json:"embedding_fields,omitempty" example:"/description,/tags"`
+	EmbeddingTemplate *string  `json:"embedding_template,omitempty" example:"{{.description}}. Tags: {{join .tags \", \"}}"`
 }
 
 // SwaggerUpdateRuleTypeRequest represents an update rule type request for Swagger documentation
 type SwaggerUpdateRuleTypeRequest struct {
-	ID   int64  `json:"id" example:"1" validate:"required"`
-	Name string `json:"name" example:"updated-security" validate:"required"`
+	ID                int64    `json:"id" example:"1" validate:"required"`
+	Name              string   `json:"name" example:"updated-security" validate:"required"`
+	EmbeddingFields   []string `json:"embedding_fields,omitempty" example:"/description,/tags"`
+	EmbeddingTemplate *string  `json:"embedding_template,omitempty" example:"{{.description}}. Tags: {{join .tags \", \"}}"`
+	Reindex           bool     `json:"reindex,omitempty" example:"true"`
+
+	ClearEmbeddingFields   bool `json:"clear_embedding_fields,omitempty" example:"false"`
+	ClearEmbeddingTemplate bool `json:"clear_embedding_template,omitempty" example:"false"`
 }
 
 // SwaggerRuleMatch represents a rule match for Swagger documentation
 type SwaggerRuleMatch struct {
 	SwaggerRule
-	Score float64 `json:"score" example:"0.95"`
+	Score float64           `json:"score" example:"0.95"`
+	Model string            `json:"model" example:"text-embedding-3-small"`
+	Chunk *SwaggerRuleChunk `json:"chunk,omitempty"`
+
+	VectorScore *float64 `json:"vector_score,omitempty" example:"0.82"`
+	RerankScore *float64 `json:"rerank_score,omitempty" example:"0.95"`
+
+	Explanation *SwaggerMatchExplanation `json:"explanation,omitempty"`
+}
+
+// SwaggerMatchExplanation represents scoring details of an explained match for Swagger documentation
+type SwaggerMatchExplanation struct {
+	QuerySimilarities []float64            `json:"query_similarities" example:"0.82,0.41"`
+	Distance          float64              `json:"distance" example:"0.18"`
+	Metric            string               `json:"metric" example:"cosine"`
+	RetrievalRank     int                  `json:"retrieval_rank" example:"3"`
+	FinalRank         int                  `json:"final_rank" example:"1"`
+	Filters           SwaggerSearchFilters `json:"filters"`
+	Plan              []string             `json:"plan,omitempty"`
+}
+
+// SwaggerSearchFilters represents restrictions applied to a search for Swagger documentation
+type SwaggerSearchFilters struct {
+	Types      *SwaggerRuleTypeFilter `json:"types,omitempty"`
+	Metadata   *SwaggerMetadataFilter `json:"metadata,omitempty"`
+	MinScore   *float64               `json:"min_score,omitempty" example:"0.6"`
+	TypeQuotas map[string]int         `json:"type_quotas,omitempty"`
+}
+
+// SwaggerRuleTypeFilter represents a rule type filter for Swagger documentation
+type SwaggerRuleTypeFilter struct {
+	Include    []string `json:"include,omitempty" example:"validation"`
+	IncludeIDs []int64  `json:"include_ids,omitempty"`
+	Exclude    []string `json:"exclude,omitempty"`
+	ExcludeIDs []int64  `json:"exclude_ids,omitempty"`
+}
+
+// SwaggerSavedRule represents a created or updated rule for Swagger documentation
+type SwaggerSavedRule struct {
+	SwaggerRule
+	PossibleDuplicates []SwaggerRuleMatch `json:"possible_duplicates,omitempty"` // With the warn dedup policy
+}
+
+// SwaggerDuplicateRulesResponse represents a rule rejected as a near-duplicate for Swagger documentation
+type SwaggerDuplicateRulesResponse struct {
+	Error      string             `json:"error" example:"rule duplicates existing rules 12"`
+	Duplicates []SwaggerRuleMatch `json:"duplicates"`
+}
+
+// SwaggerStartReindexRequest represents a start reindex request for Swagger documentation
+type SwaggerStartReindexRequest struct {
+	Model             *string `json:"model,omitempty" example:"text-embedding-3-small"`
+	Scope             string  `json:"scope" example:"stale" enums:"all,missing,stale"`
+	RuleType          *string `json:"rule_type,omitempty" example:"security"`
+	BatchSize         int     `json:"batch_size,omitempty" example:"50"`
+	MaxRulesPerSecond int     `json:"max_rules_per_second,omitempty" example:"100"`
+}
+
+// SwaggerReindexJob represents a reindex job for Swagger documentation
+type SwaggerReindexJob struct {
+	ID                int64      `json:"id" example:"1"`
+	Model             string     `json:"model" example:"text-embedding-3-small"`
+	Scope             string     `json:"scope" example:"stale"`
+	RuleType          *string    `json:"rule_type,omitempty" example:"security"`
+	BatchSize         int        `json:"batch_size" example:"50"`
+	MaxRulesPerSecond int        `json:"max_rules_per_second" example:"100"`
+	Status            string     `json:"status" example:"running" enums:"running,completed,failed,canceled"`
+	Total             int        `json:"total" example:"1000"`
+	Processed         int        `json:"processed" example:"250"`
+	LastRuleID        int64      `json:"last_rule_id" example:"250"`
+	Error             *string    `json:"error,omitempty"`
+	CreatedAt         time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt         time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+	FinishedAt        *time.Time `json:"finished_at,omitempty" example:"2023-01-01T00:10:00Z"`
+}
+
+// SwaggerSearchRulesRequest represents a similarity search request for Swagger documentation
+type SwaggerSearchRulesRequest struct {
+	N       int      `json:"n" example:"5" minimum:"1" maximum:"100" validate:"required"`
+	Type    *string  `json:"type,omitempty" example:"validation"`
+	Queries []string `json:"queries" example:"email validation,check email format" validate:"required"`
+	Model   *string  `json:"model,omitempty" example:"text-embedding-3-small"`
+	Fusion  string   `json:"fusion,omitempty" example:"rrf" enums:"average,max,rrf,sum"`
+	Mode    string   `json:"mode,omitempty" example:"hybrid" enums:"vector,hybrid,hybrid_rrf"`
+	Alpha   *float64 `json:"alpha,omitempty" example:"0.7" minimum:"0" maximum:"1"`
+	Metric  string   `json:"metric,omitempty" example:"cosine" enums:"cosine,l2,inner_product"`
+
+	MinScore  *float64 `json:"min_score,omitempty" example:"0.6"`
+	ScoreMode string   `json:"score_mode,omitempty" example:"calibrated" enums:"raw,calibrated"`
+
+	Filter *SwaggerMetadataFilter `json:"filter,omitempty"`
+
+	Types          []string       `json:"types,omitempty" example:"validation,business_logic"`
+	TypeIDs        []int64        `json:"type_ids,omitempty"`
+	ExcludeTypes   []string       `json:"exclude_types,omitempty" example:"filtering"`
+	ExcludeTypeIDs []int64        `json:"exclude_type_ids,omitempty"`
+	TypeQuotas     map[string]int `json:"type_quotas,omitempty"`
+
+	MMRLambda *float64 `json:"mmr_lambda,omitempty" example:"0.7" minimum:"0" maximum:"1"`
+	Rerank    bool     `json:"rerank,omitempty" example:"true"`
+
+	Explain bool `json:"explain,omitempty" example:"true"`
+}
+
+// SwaggerSearchRulesBatchRequest represents a batch of independent searches for Swagger documentation
+type SwaggerSearchRulesBatchRequest struct {
+	Groups []SwaggerSearchRulesRequest `json:"groups" validate:"required"`
+}
+
+// SwaggerSearchRulesBatchResponse represents batch search results for Swagger documentation
+type SwaggerSearchRulesBatchResponse struct {
+	Results []SwaggerBatchSearchResult `json:"results"`
+}
+
+// SwaggerBatchSearchResult represents the result of one search of a batch for Swagger documentation
+type SwaggerBatchSearchResult struct {
+	Rules  []SwaggerRuleMatch `json:"rules,omitempty"`
+	Error  string             `json:"error,omitempty" example:"invalid input: queries cannot be empty"`
+	Status int                `json:"status,omitempty" example:"400"`
+}
+
+// SwaggerMetadataFilter represents a condition on rule content for Swagger documentation
+type SwaggerMetadataFilter struct {
+	Op      string                  `json:"op" example:"eq" enums:"eq,ne,in,range,exists,contains,and,or"`
+	Path    string                  `json:"path,omitempty" example:"/required"`
+	Value   interface{}             `json:"value,omitempty"`
+	Values  []interface{}           `json:"values,omitempty"`
+	Gt      interface{}             `json:"gt,omitempty"`
+	Gte     interface{}             `json:"gte,omitempty"`
+	Lt      interface{}             `json:"lt,omitempty"`
+	Lte     interface{}             `json:"lte,omitempty"`
+	Filters []SwaggerMetadataFilter `json:"filters,omitempty"`
+}
+
+// SwaggerSearchRulesResponse represents a similarity search response for Swagger documentation
+type SwaggerSearchRulesResponse struct {
+	Rules []SwaggerRuleMatch `json:"rules"`
 }
 
 // SwaggerErrorResponse represents an error response for Swagger documentation
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// ReindexHandler handles HTTP requests for background reindex jobs
+type ReindexHandler struct {
+	reindexService domain.ReindexService
+}
+
+// NewReindexHandler creates a new reindex handler
+func NewReindexHandler(reindexService domain.ReindexService) *ReindexHandler {
+	return &ReindexHandler{
+		reindexService: reindexService,
+	}
+}
+
+// StartReindex starts a background reindex job
+// @Summary Start a reindex job
+// @Description Start a background job regenerating rule embeddings for a model
+// @Tags reindex
+// @Accept json
+// @Produce json
+// @Param job body SwaggerStartReindexRequest true "Reindex job parameters"
+// @Success 202 {object} SwaggerReindexJob
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /reindex/jobs [post]
+func (h *ReindexHandler) StartReindex(c echo.Context) error {
+	var req domain.StartReindexRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	job, err := h.reindexService.StartReindex(c.Request().Context(), &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// GetReindexJob returns the progress of a reindex job
+// @Summary Get reindex job by ID
+// @Description Get status and progress of a reindex job
+// @Tags reindex
+// @Produce json
+// @Param id path int true "Reindex job ID"
+// @Success 200 {object} SwaggerReindexJob
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 404 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /reindex/jobs/{id} [get]
+func (h *ReindexHandler) GetReindexJob(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid reindex job id"})
+	}
+
+	job, err := h.reindexService.GetReindexJob(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrReindexJobNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "reindex job not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// ListReindexJobs lists reindex jobs, newest first
+// @Summary List reindex jobs
+// @Description List reindex jobs with pagination, newest first
+// @Tags reindex
+// @Produce json
+// @Param limit query int false "Items per page" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} map[string]interface{}
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /reindex/jobs [get]
+func (h *ReindexHandler) ListReindexJobs(c echo.Context) error {
+	limitStr := c.QueryParam("limit")
+	limit := 10 // default
+	if limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offsetStr := c.QueryParam("offset")
+	offset := 0 // default
+	if offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	jobs, err := h.reindexService.ListReindexJobs(c.Request().Context(), limit, offset)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"jobs":   jobs,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+// CancelReindexJob cancels a running reindex job
+// @Summary Cancel a reindex job
+// @Description Cancel a running reindex job; embeddings already written are kept
+// @Tags reindex
+// @Produce json
+// @Param id path int true "Reindex job ID"
+// @Success 200 {object} SwaggerReindexJob
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 404 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /reindex/jobs/{id}/cancel [post]
+func (h *ReindexHandler) CancelReindexJob(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid reindex job id"})
+	}
+
+	job, err := h.reindexService.CancelReindexJob(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrReindexJobNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "reindex job not found"})
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
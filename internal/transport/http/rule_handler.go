@@ -1,8 +1,11 @@
 package http
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ratmirtech/vector-rules-service/internal/domain"
@@ -22,13 +25,14 @@ func NewRuleHandler(ruleService domain.RuleService) *RuleHandler {
 
 // CreateRule creates a new rule
 // @Summary Create a new rule
-// @Description Create a new rule with embedding generation
+// @Description Create a new rule with embedding generation; near-duplicates of the same type are handled by the dedup policy
 // @Tags rules
 // @Accept json
 // @Produce json
 // @Param rule body SwaggerCreateRuleRequest true "Rule creation request"
-// @Success 201 {object} SwaggerRule
+// @Success 201 {object} SwaggerSavedRule
 // @Failure 400 {object} SwaggerErrorResponse
+// @Failure 409 {object} SwaggerDuplicateRulesResponse
 // @Failure 500 {object} SwaggerErrorResponse
 // @Router /rules [post]
 func (h *RuleHandler) CreateRule(c echo.Context) error {
@@ -39,6 +43,13 @@ func (h *RuleHandler) CreateRule(c echo.Context) error {
 
 	rule, err := h.ruleService.CreateRule(c.Request().Context(), &req)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		var duplicateErr *domain.DuplicateRulesError
+		if errors.As(err, &duplicateErr) {
+			return duplicateRules(c, duplicateErr)
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -76,15 +87,16 @@ func (h *RuleHandler) GetRule(c echo.Context) error {
 
 // UpdateRule updates an existing rule
 // @Summary Update a rule
-// @Description Update an existing rule and regenerate embedding
+// @Description Update an existing rule and regenerate embedding; near-duplicates of the same type are handled by the dedup policy
 // @Tags rules
 // @Accept json
 // @Produce json
 // @Param id path int true "Rule ID"
 // @Param rule body SwaggerUpdateRuleRequest true "Rule update request"
-// @Success 200 {object} SwaggerRule
+// @Success 200 {object} SwaggerSavedRule
 // @Failure 400 {object} SwaggerErrorResponse
 // @Failure 404 {object} SwaggerErrorResponse
+// @Failure 409 {object} SwaggerDuplicateRulesResponse
 // @Failure 500 {object} SwaggerErrorResponse
 // @Router /rules/{id} [put]
 func (h *RuleHandler) UpdateRule(c echo.Context) error {
@@ -102,15 +114,30 @@ func (h *RuleHandler) UpdateRule(c echo.Context) error {
 
 	rule, err := h.ruleService.UpdateRule(c.Request().Context(), &req)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		if err == domain.ErrRuleNotFound {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "rule not found"})
 		}
+		var duplicateErr *domain.DuplicateRulesError
+		if errors.As(err, &duplicateErr) {
+			return duplicateRules(c, duplicateErr)
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
 	return c.JSON(http.StatusOK, rule)
 }
 
+// duplicateRules responds with the existing rules a saved rule duplicates
+func duplicateRules(c echo.Context, err *domain.DuplicateRulesError) error {
+	return c.JSON(http.StatusConflict, map[string]interface{}{
+		"error":      err.Error(),
+		"duplicates": err.Duplicates,
+	})
+}
+
 // DeleteRule deletes a rule
 // @Summary Delete a rule
 // @Description Delete a rule by ID
@@ -146,16 +173,27 @@ func (h *RuleHandler) DeleteRule(c echo.Context) error {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param type query []string false "Rule type names to include, repeated or comma separated" collectionFormat(multi)
+// @Param type_id query []int false "Rule type IDs to include" collectionFormat(multi)
+// @Param exclude_type query []string false "Rule type names to exclude" collectionFormat(multi)
+// @Param exclude_type_id query []int false "Rule type IDs to exclude" collectionFormat(multi)
+// @Param filter query string false "Metadata filter on rule content as JSON, e.g. {\"op\":\"eq\",\"path\":\"/required\",\"value\":true}"
 // @Success 200 {object} SwaggerListResponse
 // @Failure 400 {object} SwaggerErrorResponse
 // @Failure 500 {object} SwaggerErrorResponse
 // @Router /rules [get]
 func (h *RuleHandler) ListRules(c echo.Context) error {
 	// Parse query parameters
-	ruleType := c.QueryParam("type")
-	var ruleTypePtr *string
-	if ruleType != "" {
-		ruleTypePtr = &ruleType
+	types := &domain.RuleTypeFilter{
+		Include: queryList(c, "type"),
+		Exclude: queryList(c, "exclude_type"),
+	}
+	var err error
+	if types.IncludeIDs, err = queryIDs(c, "type_id"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid type_id"})
+	}
+	if types.ExcludeIDs, err = queryIDs(c, "exclude_type_id"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid exclude_type_id"})
 	}
 
 	limitStr := c.QueryParam("limit")
@@ -174,8 +212,19 @@ func (h *RuleHandler) ListRules(c echo.Context) error {
 		}
 	}
 
-	rules, err := h.ruleService.ListRules(c.Request().Context(), ruleTypePtr, limit, offset)
+	var filter *domain.MetadataFilter
+	if filterStr := c.QueryParam("filter"); filterStr != "" {
+		filter = &domain.MetadataFilter{}
+		if err := json.Unmarshal([]byte(filterStr), filter); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid filter"})
+		}
+	}
+
+	rules, err := h.ruleService.ListRules(c.Request().Context(), types, filter, limit, offset)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -185,3 +234,201 @@ func (h *RuleHandler) ListRules(c echo.Context) error {
 		"offset": offset,
 	})
 }
+
+// SearchRules finds rules similar to the given queries
+// @Summary Search similar rules
+// @Description Vector or hybrid (vector + full-text) similarity search over rules; several queries are combined with the fusion strategy
+// @Tags rules
+// @Accept json
+// @Produce json
+// @Param query body SwaggerSearchRulesRequest true "Search request"
+// @Success 200 {object} SwaggerSearchRulesResponse
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 404 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /rules/search [post]
+func (h *RuleHandler) SearchRules(c echo.Context) error {
+	var query domain.RetrieveRulesQuery
+	if err := c.Bind(&query); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	matches, err := h.ruleService.RetrieveSimilar(c.Request().Context(), &query)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrRuleTypeNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "rule type not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	if matches == nil {
+		matches = []*domain.RuleMatch{}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"rules": matches,
+	})
+}
+
+// SearchRulesBatch runs independent searches in one request
+// @Summary Search similar rules in batch
+// @Description Runs independent searches, embedding all their queries at once; a failing group does not fail the others
+// @Tags rules
+// @Accept json
+// @Produce json
+// @Param batch body SwaggerSearchRulesBatchRequest true "Batch search request"
+// @Success 200 {object} SwaggerSearchRulesBatchResponse
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /rules/search/batch [post]
+func (h *RuleHandler) SearchRulesBatch(c echo.Context) error {
+	var req struct {
+		Groups []*domain.RetrieveRulesQuery `json:"groups"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	results, err := h.ruleService.RetrieveBatch(c.Request().Context(), req.Groups)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	response := make([]map[string]interface{}, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			response[i] = map[string]interface{}{
+				"error":  result.Err.Error(),
+				"status": searchErrorStatus(result.Err),
+			}
+			continue
+		}
+
+		matches := result.Matches
+		if matches == nil {
+			matches = []*domain.RuleMatch{}
+		}
+		response[i] = map[string]interface{}{
+			"rules": matches,
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"results": response,
+	})
+}
+
+// searchErrorStatus returns the HTTP status of a search failure
+func searchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrRuleTypeNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FindSimilarRules finds rules similar to an existing rule
+// @Summary Find rules similar to a rule
+// @Description Similarity search with the stored embedding of the rule, excluding the rule itself; no embedding is generated
+// @Tags rules
+// @Produce json
+// @Param id path int true "Rule ID"
+// @Param n query int false "Number of rules to return" default(10)
+// @Param model query string false "Embedding model to compare with, defaults to the primary model"
+// @Param metric query string false "Distance metric: cosine, l2 or inner_product"
+// @Param type query []string false "Rule type names to include, repeated or comma separated" collectionFormat(multi)
+// @Param type_id query []int false "Rule type IDs to include" collectionFormat(multi)
+// @Param exclude_type query []string false "Rule type names to exclude" collectionFormat(multi)
+// @Param exclude_type_id query []int false "Rule type IDs to exclude" collectionFormat(multi)
+// @Success 200 {object} SwaggerSearchRulesResponse
+// @Failure 400 {object} SwaggerErrorResponse
+// @Failure 404 {object} SwaggerErrorResponse
+// @Failure 500 {object} SwaggerErrorResponse
+// @Router /rules/{id}/similar [get]
+func (h *RuleHandler) FindSimilarRules(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid rule id"})
+	}
+
+	query := &domain.SimilarRulesQuery{
+		RuleID: id,
+		N:      10, // default
+		Metric: domain.DistanceMetric(c.QueryParam("metric")),
+		Types: &domain.RuleTypeFilter{
+			Include: queryList(c, "type"),
+			Exclude: queryList(c, "exclude_type"),
+		},
+	}
+	if nStr := c.QueryParam("n"); nStr != "" {
+		if query.N, err = strconv.Atoi(nStr); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid n"})
+		}
+	}
+	if model := c.QueryParam("model"); model != "" {
+		query.Model = &model
+	}
+	if query.Types.IncludeIDs, err = queryIDs(c, "type_id"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid type_id"})
+	}
+	if query.Types.ExcludeIDs, err = queryIDs(c, "exclude_type_id"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid exclude_type_id"})
+	}
+
+	matches, err := h.ruleService.FindSimilarRules(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrRuleNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "rule not found"})
+		}
+		if errors.Is(err, domain.ErrRuleTypeNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "rule type not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	if matches == nil {
+		matches = []*domain.RuleMatch{}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"rules": matches,
+	})
+}
+
+// queryList returns the values of a repeated query parameter, also splitting comma separated values
+func queryList(c echo.Context, name string) []string {
+	var values []string
+	for _, param := range c.QueryParams()[name] {
+		for _, value := range strings.Split(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// queryIDs returns the values of a repeated query parameter parsed as IDs
+func queryIDs(c echo.Context, name string) ([]int64, error) {
+	var ids []int64
+	for _, value := range queryList(c, name) {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -39,6 +40,9 @@ func (h *RuleTypeHandler) CreateRuleType(c echo.Context) error {
 
 	ruleType, err := h.ruleTypeService.CreateRuleType(c.Request().Context(), &req)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -102,6 +106,9 @@ func (h *RuleTypeHandler) UpdateRuleType(c echo.Context) error {
 
 	ruleType, err := h.ruleTypeService.UpdateRuleType(c.Request().Context(), &req)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		if err == domain.ErrRuleTypeNotFound {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "rule type not found"})
 		}
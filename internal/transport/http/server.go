@@ -9,15 +9,19 @@ import (
 
 // Server represents the HTTP server
 type Server struct {
-	echo            *echo.Echo
-	ruleHandler     *RuleHandler
-	ruleTypeHandler *RuleTypeHandler
+	echo             *echo.Echo
+	ruleHandler      *RuleHandler
+	ruleTypeHandler  *RuleTypeHandler
+	embeddingHandler *EmbeddingHandler
+	reindexHandler   *ReindexHandler
 }
 
 // NewServer creates a new HTTP server
 func NewServer(
 	ruleService domain.RuleService,
 	ruleTypeService domain.RuleTypeService,
+	embeddingStats domain.EmbeddingStatsReporter,
+	reindexService domain.ReindexService,
 ) *Server {
 	e := echo.New()
 
@@ -29,11 +33,15 @@ func NewServer(
 	// Handlers
 	ruleHandler := NewRuleHandler(ruleService)
 	ruleTypeHandler := NewRuleTypeHandler(ruleTypeService)
+	embeddingHandler := NewEmbeddingHandler(embeddingStats)
+	reindexHandler := NewReindexHandler(reindexService)
 
 	server := &Server{
-		echo:            e,
-		ruleHandler:     ruleHandler,
-		ruleTypeHandler: ruleTypeHandler,
+		echo:             e,
+		ruleHandler:      ruleHandler,
+		ruleTypeHandler:  ruleTypeHandler,
+		embeddingHandler: embeddingHandler,
+		reindexHandler:   reindexHandler,
 	}
 
 	server.setupRoutes()
@@ -55,7 +63,10 @@ func (s *Server) setupRoutes() {
 
 	// Rules routes
 	v1.POST("/rules", s.ruleHandler.CreateRule)
+	v1.POST("/rules/search", s.ruleHandler.SearchRules)
+	v1.POST("/rules/search/batch", s.ruleHandler.SearchRulesBatch)
 	v1.GET("/rules/:id", s.ruleHandler.GetRule)
+	v1.GET("/rules/:id/similar", s.ruleHandler.FindSimilarRules)
 	v1.PUT("/rules/:id", s.ruleHandler.UpdateRule)
 	v1.DELETE("/rules/:id", s.ruleHandler.DeleteRule)
 	v1.GET("/rules", s.ruleHandler.ListRules)
@@ -66,6 +77,15 @@ func (s *Server) setupRoutes() {
 	v1.PUT("/rule-types/:id", s.ruleTypeHandler.UpdateRuleType)
 	v1.DELETE("/rule-types/:id", s.ruleTypeHandler.DeleteRuleType)
 	v1.GET("/rule-types", s.ruleTypeHandler.ListRuleTypes)
+
+	// Embedding provider diagnostics
+	v1.GET("/embeddings/stats", s.embeddingHandler.GetStats)
+
+	// Reindex jobs routes
+	v1.POST("/reindex/jobs", s.reindexHandler.StartReindex)
+	v1.GET("/reindex/jobs", s.reindexHandler.ListReindexJobs)
+	v1.GET("/reindex/jobs/:id", s.reindexHandler.GetReindexJob)
+	v1.POST("/reindex/jobs/:id/cancel", s.reindexHandler.CancelReindexJob)
 }
 
 // Start starts the HTTP server
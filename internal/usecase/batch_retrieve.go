@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	maxBatchGroups = 256
+	// batchWorkers bounds the number of query groups searched concurrently
+	batchWorkers = 8
+)
+
+// RetrieveBatch embeds the queries of all groups with one provider call per model and
+// searches the groups concurrently. A failing group does not fail the others.
+func (s *ruleService) RetrieveBatch(ctx context.Context, queries []*domain.RetrieveRulesQuery) ([]*domain.BatchRetrieveResult, error) {
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("%w: batch cannot be empty", domain.ErrInvalidInput)
+	}
+	if len(queries) > maxBatchGroups {
+		return nil, fmt.Errorf("%w: at most %d query groups are allowed", domain.ErrInvalidInput, maxBatchGroups)
+	}
+
+	results := make([]*domain.BatchRetrieveResult, len(queries))
+	models := make([]string, len(queries))
+	offsets := make([]int, len(queries))
+
+	// Queries of valid groups are collected per model, every group keeps the offset of its first query
+	providers := make(map[string]domain.EmbeddingProvider)
+	texts := make(map[string][]string)
+	for i, query := range queries {
+		results[i] = &domain.BatchRetrieveResult{}
+		if query == nil {
+			results[i].Err = fmt.Errorf("%w: query group cannot be empty", domain.ErrInvalidInput)
+			continue
+		}
+
+		provider, err := s.prepareRetrieve(ctx, query)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		model := provider.Model()
+		providers[model] = provider
+		models[i] = model
+		offsets[i] = len(texts[model])
+		texts[model] = append(texts[model], query.Queries...)
+	}
+
+	// A failover provider may answer with a fallback, whose vectors are searched under its own model
+	embeds := make(map[string][][]float32, len(texts))
+	served := make(map[string]string, len(texts))
+	embedErrs := make(map[string]error)
+	for model, modelTexts := range texts {
+		embedded, servedModel, err := generateEmbeddings(ctx, providers[model], modelTexts)
+		if err != nil {
+			embedErrs[model] = fmt.Errorf("failed to generate embeddings: %w", err)
+			continue
+		}
+		embeds[model] = embedded
+		served[model] = servedModel
+	}
+
+	groups := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < batchWorkers && w < len(queries); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range groups {
+				query, model := queries[i], models[i]
+				groupEmbeds := embeds[model][offsets[i] : offsets[i]+len(query.Queries)]
+				results[i].Matches, _, results[i].Err = s.retrieveEmbedded(ctx, query, served[model], groupEmbeds)
+			}
+		}()
+	}
+
+	for i, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		if err := embedErrs[models[i]]; err != nil {
+			result.Err = err
+			continue
+		}
+		groups <- i
+	}
+	close(groups)
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// Chunking strategies
+const (
+	// ChunkBySize splits the rendered text into windows of MaxChars with Overlap
+	ChunkBySize = "size"
+	// ChunkByFields keeps extracted fields together, packing them into chunks of up to MaxChars
+	ChunkByFields = "fields"
+	// ChunkingNone embeds the whole text as a single chunk
+	ChunkingNone = "none"
+)
+
+// ChunkingOptions configures how rule text is split into separately embedded chunks
+type ChunkingOptions struct {
+	Strategy string
+	MaxChars int
+	Overlap  int
+}
+
+// ruleChunks renders rule content of the given type and splits it into chunks
+func ruleChunks(ruleType *domain.RuleType, content json.RawMessage, opts ChunkingOptions) ([]string, error) {
+	text, err := embeddingText(ruleType, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.MaxChars <= 0 || len([]rune(text)) <= opts.MaxChars {
+		return []string{text}, nil
+	}
+
+	switch opts.Strategy {
+	case ChunkingNone:
+		return []string{text}, nil
+	case ChunkByFields:
+		parts, err := embeddingParts(ruleType, content)
+		if err != nil {
+			return nil, err
+		}
+		return packChunks(parts, opts.MaxChars, opts.Overlap), nil
+	default:
+		return splitBySize(text, opts.MaxChars, opts.Overlap), nil
+	}
+}
+
+// packChunks joins consecutive fields into chunks of up to maxChars; longer fields are split by size
+func packChunks(parts []string, maxChars, overlap int) []string {
+	var chunks []string
+	var current strings.Builder
+	currentLen := 0
+
+	flush := func() {
+		if currentLen > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+			currentLen = 0
+		}
+	}
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		partLen := len([]rune(part))
+		switch {
+		case partLen == 0:
+			continue
+		case partLen > maxChars:
+			flush()
+			chunks = append(chunks, splitBySize(part, maxChars, overlap)...)
+			continue
+		case currentLen > 0 && currentLen+1+partLen > maxChars:
+			flush()
+		}
+
+		if currentLen > 0 {
+			current.WriteString("\n")
+			currentLen++
+		}
+		current.WriteString(part)
+		currentLen += partLen
+	}
+	flush()
+
+	return chunks
+}
+
+// splitBySize splits text into windows of up to maxChars runes, preferring to cut at whitespace.
+// Consecutive windows share about overlap runes, starting at a word boundary.
+func splitBySize(text string, maxChars, overlap int) []string {
+	runes := []rune(text)
+	if len(runes) <= maxChars {
+		return []string{text}
+	}
+	if overlap < 0 {
+		overlap = 0
+	}
+	if overlap > maxChars/2 {
+		overlap = maxChars / 2
+	}
+
+	var chunks []string
+	for start := 0; start < len(runes); {
+		end := start + maxChars
+		if end >= len(runes) {
+			end = len(runes)
+		} else {
+			// Cut at the last whitespace in the second half of the window
+			for i := end; i > start+maxChars/2; i-- {
+				if unicode.IsSpace(runes[i]) {
+					end = i
+					break
+				}
+			}
+		}
+
+		if chunk := strings.TrimSpace(string(runes[start:end])); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		if end == len(runes) {
+			break
+		}
+
+		next := end - overlap
+		for next < end && !unicode.IsSpace(runes[next-1]) {
+			next++
+		}
+		start = next
+	}
+
+	return chunks
+}
+
+// embedChunks embeds the chunks of several rules with a single provider call.
+// The rule vector is the average of its chunk vectors, stored under the model that produced them.
+func embedChunks(ctx context.Context, provider domain.EmbeddingProvider, chunkSets [][]string) ([]domain.RuleEmbedding, error) {
+	var texts []string
+	for _, chunks := range chunkSets {
+		texts = append(texts, chunks...)
+	}
+
+	vectors, model, err := generateEmbeddings(ctx, provider, texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate embeddings with model %s: %w", provider.Model(), err)
+	}
+
+	result := make([]domain.RuleEmbedding, len(chunkSets))
+	offset := 0
+	for i, chunks := range chunkSets {
+		ruleVectors := vectors[offset : offset+len(chunks)]
+		offset += len(chunks)
+
+		ruleChunks := make([]domain.RuleChunk, len(chunks))
+		for j, chunk := range chunks {
+			ruleChunks[j] = domain.RuleChunk{Index: j, Text: chunk, Vector: ruleVectors[j]}
+		}
+
+		pooled := ruleVectors[0]
+		if len(ruleVectors) > 1 {
+			pooled, err = averageEmbeddings(ruleVectors)
+			if err != nil {
+				return nil, fmt.Errorf("failed to pool chunk embeddings: %w", err)
+			}
+		}
+
+		result[i] = domain.RuleEmbedding{
+			Model:      model,
+			Dimensions: len(pooled),
+			Vector:     pooled,
+			Chunks:     ruleChunks,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// maxDuplicates limits the number of near-duplicates reported for a rule
+const maxDuplicates = 10
+
+// DedupOptions configures near-duplicate detection on rule create and update
+type DedupOptions struct {
+	Policy    domain.DedupPolicy // Policy of requests without one
+	Threshold float64            // Similarity at which existing rules of the same type are duplicates
+}
+
+// dedupPolicy returns the requested policy, defaulting to the configured one
+func (s *ruleService) dedupPolicy(policy domain.DedupPolicy) (domain.DedupPolicy, error) {
+	if policy == "" {
+		return s.dedup.Policy, nil
+	}
+	if !policy.IsValid() {
+		return "", fmt.Errorf("%w: unknown dedup policy '%s'", domain.ErrInvalidInput, policy)
+	}
+	return policy, nil
+}
+
+// findDuplicates searches rules of the type similar to the rule embeddings above the threshold.
+// The pooled vector of the default provider is compared within the model that produced it;
+// excludeID skips the updated rule itself.
+func (s *ruleService) findDuplicates(ctx context.Context, policy domain.DedupPolicy, ruleTypeID, excludeID int64, ruleEmbeddings []domain.RuleEmbedding) ([]*domain.RuleMatch, error) {
+	if policy == domain.DedupAllow {
+		return nil, nil
+	}
+
+	if len(ruleEmbeddings) == 0 {
+		return nil, nil
+	}
+	embedding := ruleEmbeddings[0]
+
+	search := &domain.SimilaritySearch{
+		Embedding: embedding.Vector,
+		Model:     embedding.Model,
+		Types:     &domain.RuleTypeFilter{IncludeIDs: []int64{ruleTypeID}},
+		Limit:     maxDuplicates,
+		Metric:    s.search.Metric,
+		MinScore:  &s.dedup.Threshold,
+	}
+	if excludeID != 0 {
+		search.ExcludeIDs = []int64{excludeID}
+	}
+
+	duplicates, err := s.ruleRepo.FindSimilar(ctx, search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate rules: %w", err)
+	}
+
+	if len(duplicates) > 0 && policy == domain.DedupReject {
+		return nil, &domain.DuplicateRulesError{Duplicates: duplicates}
+	}
+	return duplicates, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+// embeddingModels is the list of configured embedding providers, the default one first
+type embeddingModels []domain.EmbeddingProvider
+
+func newEmbeddingModels(defaultProvider domain.EmbeddingProvider, additional []domain.EmbeddingProvider) embeddingModels {
+	return append(embeddingModels{defaultProvider}, additional...)
+}
+
+// providerFor returns the embedding provider of the requested model, or the default one
+func (m embeddingModels) providerFor(model *string) (domain.EmbeddingProvider, error) {
+	if model == nil || *model == "" {
+		return m[0], nil
+	}
+
+	for _, provider := range m {
+		if provider.Model() == *model {
+			return provider, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown embedding model '%s'", domain.ErrInvalidInput, *model)
+}
+
+// generateEmbeddings embeds texts and returns the model that produced the vectors.
+// It differs from provider.Model() when a failover chain answered with a fallback provider,
+// whose vectors must be stored and searched under its own model.
+// Vectors are scaled to unit length, as search scores assume for every distance metric.
+func generateEmbeddings(ctx context.Context, provider domain.EmbeddingProvider, texts []string) ([][]float32, string, error) {
+	var vectors [][]float32
+	var model string
+	var err error
+	if reporter, ok := provider.(domain.ServingModelReporter); ok {
+		vectors, model, err = reporter.GenerateBatchEmbeddingsWithModel(ctx, texts)
+	} else {
+		vectors, err = provider.GenerateBatchEmbeddings(ctx, texts)
+		model = provider.Model()
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, vector := range vectors {
+		vectors[i] = embeddings.Normalize(vector)
+	}
+	return vectors, model, nil
+}
+
+// averageEmbeddings averages embeddings into a single unit-length embedding
+func averageEmbeddings(vectors [][]float32) ([]float32, error) {
+	average, err := embeddings.AverageEmbeddings(vectors)
+	if err != nil {
+		return nil, err
+	}
+	return embeddings.Normalize(average), nil
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// templateValueFunc is appended to every printing action of an embedding template, so that
+// missing content keys and JSON nulls render as empty text instead of "<no value>"
+const templateValueFunc = "value"
+
+// embeddingTemplateFuncs are available in rule type embedding templates
+var embeddingTemplateFuncs = template.FuncMap{
+	templateValueFunc: func(value interface{}) interface{} {
+		if value == nil {
+			return ""
+		}
+		return value
+	},
+	"join": func(values []interface{}, sep string) string {
+		parts := make([]string, 0, len(values))
+		for _, value := range values {
+			parts = append(parts, fmt.Sprint(value))
+		}
+		return strings.Join(parts, sep)
+	},
+}
+
+// validateEmbeddingTemplate checks the text extraction settings of a rule type
+func validateEmbeddingTemplate(fields []string, tmpl *string) error {
+	for _, field := range fields {
+		if field != "" && !strings.HasPrefix(field, "/") {
+			return fmt.Errorf("%w: embedding field '%s' must be a JSON pointer starting with '/'", domain.ErrInvalidInput, field)
+		}
+	}
+
+	if tmpl != nil {
+		if _, err := parseEmbeddingTemplate(*tmpl); err != nil {
+			return fmt.Errorf("%w: invalid embedding template: %v", domain.ErrInvalidInput, err)
+		}
+	}
+
+	return nil
+}
+
+// embeddingText renders the text embedded for rule content of the given type.
+// Content without any extractable text is embedded as raw JSON.
+func embeddingText(ruleType *domain.RuleType, content json.RawMessage) (string, error) {
+	parts, err := embeddingParts(ruleType, content)
+	if err != nil {
+		return "", err
+	}
+
+	text := strings.TrimSpace(strings.Join(parts, "\n"))
+	if text == "" {
+		return string(content), nil
+	}
+	return text, nil
+}
+
+// embeddingParts extracts the text fragments of rule content of the given type
+func embeddingParts(ruleType *domain.RuleType, content json.RawMessage) ([]string, error) {
+	var parts []string
+	var err error
+
+	switch {
+	case ruleType.EmbeddingTemplate != nil:
+		parts, err = templateText(*ruleType.EmbeddingTemplate, content)
+	case len(ruleType.EmbeddingFields) > 0:
+		parts, err = fieldsText(ruleType.EmbeddingFields, content)
+	default:
+		parts, err = stringValues(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to render embedding text for rule type '%s': %v", domain.ErrInvalidInput, ruleType.Name, err)
+	}
+
+	return parts, nil
+}
+
+// parseEmbeddingTemplate compiles a rule type embedding template
+func parseEmbeddingTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("embedding").Funcs(embeddingTemplateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			printValues(t.Tree, t.Tree.Root)
+		}
+	}
+	return tmpl, nil
+}
+
+// printValues pipes the output of every printing action under node through templateValueFunc
+func printValues(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			printValues(tree, child)
+		}
+	case *parse.ActionNode:
+		// Actions declaring variables print nothing
+		if len(n.Pipe.Decl) > 0 {
+			return
+		}
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      n.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(templateValueFunc).SetTree(tree).SetPos(n.Pos)},
+		})
+	case *parse.IfNode:
+		printValues(tree, n.List)
+		printValues(tree, n.ElseList)
+	case *parse.RangeNode:
+		printValues(tree, n.List)
+		printValues(tree, n.ElseList)
+	case *parse.WithNode:
+		printValues(tree, n.List)
+		printValues(tree, n.ElseList)
+	}
+}
+
+// templateText executes a Go text/template over the decoded content
+func templateText(text string, content json.RawMessage) ([]string, error) {
+	tmpl, err := parseEmbeddingTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := decodeContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, doc); err != nil {
+		return nil, err
+	}
+	return []string{buf.String()}, nil
+}
+
+// fieldsText resolves JSON pointers against the content, skipping missing fields
+func fieldsText(fields []string, content json.RawMessage) ([]string, error) {
+	doc, err := decodeContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []string
+	for _, field := range fields {
+		if value, ok := resolvePointer(doc, field); ok {
+			parts = appendValues(parts, value)
+		}
+	}
+	return parts, nil
+}
+
+// stringValues returns every string value of the content in document order; object keys are skipped
+func stringValues(content json.RawMessage) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+
+	// For every open container: whether it is an object and whether its next token is a value
+	type frame struct{ object, value bool }
+	var stack []frame
+	var parts []string
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		// Object members alternate between keys and values
+		if n := len(stack); n > 0 && stack[n-1].object {
+			isKey := !stack[n-1].value
+			stack[n-1].value = !stack[n-1].value
+			if isKey {
+				continue
+			}
+		}
+
+		switch t := tok.(type) {
+		case json.Delim:
+			stack = append(stack, frame{object: t == '{'})
+		case string:
+			parts = append(parts, t)
+		}
+	}
+}
+
+// decodeContent decodes rule content into generic JSON values
+func decodeContent(content json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// resolvePointer resolves an RFC 6901 JSON pointer against a decoded document
+func resolvePointer(doc interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+
+	current := doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// appendValues appends the scalar values of a decoded JSON value, walking objects in key order
+func appendValues(parts []string, value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return append(parts, v)
+	case json.Number:
+		return append(parts, v.String())
+	case bool:
+		return append(parts, strconv.FormatBool(v))
+	case []interface{}:
+		for _, item := range v {
+			parts = appendValues(parts, item)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			parts = appendValues(parts, v[key])
+		}
+	}
+	return parts
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+func TestEmbeddingTextTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		content  string
+		want     string
+	}{
+		{
+			name:     "fields",
+			template: `{{.description}}. Tags: {{join .tags ", "}}`,
+			content:  `{"description":"Check email","tags":["email","format"]}`,
+			want:     "Check email. Tags: email, format",
+		},
+		{
+			name:     "missing key",
+			template: `{{.description}}|{{.missing}}`,
+			content:  `{"description":"Check email"}`,
+			want:     "Check email|",
+		},
+		{
+			name:     "null value",
+			template: `{{.description}}|{{.note}}`,
+			content:  `{"description":"Check email","note":null}`,
+			want:     "Check email|",
+		},
+		{
+			name:     "literal placeholder in content",
+			template: `{{.description}}`,
+			content:  `{"description":"Show <no value> for empty cells"}`,
+			want:     "Show <no value> for empty cells",
+		},
+		{
+			name:     "nested actions",
+			template: `{{range .items}}{{.name}};{{end}}{{if .flag}}{{.absent}}flag{{end}}{{with $x := .description}}{{$x}}{{end}}`,
+			content:  `{"items":[{"name":"a"},{"other":1}],"flag":true,"description":"d"}`,
+			want:     "a;;flagd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleType := &domain.RuleType{Name: "test", EmbeddingTemplate: &tt.template}
+			got, err := embeddingText(ruleType, json.RawMessage(tt.content))
+			if err != nil {
+				t.Fatalf("embeddingText() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("embeddingText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+// markRetrievalRanks starts explanations of ranked candidates with their position
+func markRetrievalRanks(matches []*domain.RuleMatch) {
+	for i, match := range matches {
+		match.Explanation = &domain.MatchExplanation{RetrievalRank: i + 1}
+	}
+}
+
+// explainMatches completes explanations of the selected matches. queryEmbeddings are the
+// embeddings of the queries in query order, searched are the vectors the searches ran with.
+func (s *ruleService) explainMatches(query *domain.RetrieveRulesQuery, matches []*domain.RuleMatch, queryEmbeddings, searched [][]float32, plan []string) {
+	filters := s.searchFilters(query)
+	for i, match := range matches {
+		s.explainMatch(query, match, i+1, filters, queryEmbeddings, searched, plan)
+	}
+}
+
+// searchFilters describes the filters of a query for explanations
+func (s *ruleService) searchFilters(query *domain.RetrieveRulesQuery) *domain.SearchFilters {
+	filters := &domain.SearchFilters{
+		Metadata:   query.Filter,
+		MinScore:   s.calibration.minRawScore(query),
+		TypeQuotas: query.TypeQuotas,
+	}
+	if types := retrieveTypeFilter(query); !types.IsEmpty() {
+		filters.Types = types
+	}
+	return filters
+}
+
+// explainMatch completes the explanation of a match selected at the given rank
+func (s *ruleService) explainMatch(query *domain.RetrieveRulesQuery, match *domain.RuleMatch, rank int, filters *domain.SearchFilters, queryEmbeddings, searched [][]float32, plan []string) {
+	metric := s.distanceMetric(query.Metric)
+
+	explanation := match.Explanation
+	if explanation == nil {
+		explanation = &domain.MatchExplanation{}
+		match.Explanation = explanation
+	}
+	explanation.FinalRank = rank
+	explanation.Metric = metric
+	explanation.Filters = filters
+	explanation.Plan = plan
+
+	if match.Chunk == nil || match.Chunk.Vector == nil {
+		return
+	}
+	explanation.QuerySimilarities = make([]float64, len(queryEmbeddings))
+	for j, embedding := range queryEmbeddings {
+		explanation.QuerySimilarities[j] = vectorSimilarity(metric, vectorDistance(metric, embedding, match.Chunk.Vector))
+	}
+	explanation.Distance = math.Inf(1)
+	for _, embedding := range searched {
+		explanation.Distance = math.Min(explanation.Distance, vectorDistance(metric, embedding, match.Chunk.Vector))
+	}
+}
+
+// searchPlan returns the execution plan of a search of an explained query in debug mode, nil otherwise
+func (s *ruleService) searchPlan(ctx context.Context, query *domain.RetrieveRulesQuery, search *domain.SimilaritySearch) ([]string, error) {
+	if !query.Explain || !s.search.ExplainPlans {
+		return nil, nil
+	}
+	return s.ruleRepo.ExplainSimilar(ctx, search)
+}
+
+// vectorDistance computes the distance of the metric as the pgvector operator does
+func vectorDistance(metric domain.DistanceMetric, a, b []float32) float64 {
+	switch metric {
+	case domain.DistanceL2:
+		var sum float64
+		for i := range a {
+			d := float64(a[i]) - float64(b[i])
+			sum += d * d
+		}
+		return math.Sqrt(sum)
+	case domain.DistanceInnerProduct:
+		var dot float64
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+		}
+		return -dot
+	default:
+		return 1 - embeddings.CosineSimilarity(a, b)
+	}
+}
+
+// vectorSimilarity converts a distance to the score of the metric, as the search query does
+func vectorSimilarity(metric domain.DistanceMetric, distance float64) float64 {
+	switch metric {
+	case domain.DistanceL2:
+		return 1 - distance*distance/2
+	case domain.DistanceInnerProduct:
+		return -distance
+	default:
+		return 1 - distance
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	// rrfK dampens the influence of top ranks in reciprocal rank fusion
+	rrfK = 60
+	// fusionCandidateFactor widens per-query searches so that rules ranked just below
+	// the top N of every single query can still win after fusion
+	fusionCandidateFactor = 2
+)
+
+// validateFusion checks the fusion strategy of a query
+func validateFusion(strategy domain.FusionStrategy) error {
+	switch strategy {
+	case "", domain.FusionAverage, domain.FusionMax, domain.FusionRRF, domain.FusionSum:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown fusion strategy '%s'", domain.ErrInvalidInput, strategy)
+	}
+}
+
+// fuseMatches merges per-query result lists into deduplicated top-n matches.
+// The match kept for a rule is the one of its best scoring query, so it carries the best chunk.
+func fuseMatches(strategy domain.FusionStrategy, resultSets [][]*domain.RuleMatch, n int) []*domain.RuleMatch {
+	best := make(map[int64]*domain.RuleMatch)
+	fused := make(map[int64]float64)
+
+	for _, matches := range resultSets {
+		for rank, match := range matches {
+			switch strategy {
+			case domain.FusionMax:
+				if current, ok := fused[match.ID]; !ok || match.Score > current {
+					fused[match.ID] = match.Score
+				}
+			case domain.FusionRRF:
+				fused[match.ID] += 1.0 / float64(rrfK+rank+1)
+			case domain.FusionSum:
+				fused[match.ID] += match.Score
+			}
+
+			if current, ok := best[match.ID]; !ok || match.Score > current.Score {
+				best[match.ID] = match
+			}
+		}
+	}
+
+	result := make([]*domain.RuleMatch, 0, len(best))
+	for id, match := range best {
+		fusedMatch := *match
+		fusedMatch.Score = fused[id]
+		result = append(result, &fusedMatch)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
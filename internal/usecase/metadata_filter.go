@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	// maxFilterConditions limits the size of a metadata filter expression
+	maxFilterConditions = 64
+	// maxFilterDepth limits nesting of and/or filters
+	maxFilterDepth = 8
+)
+
+// validateMetadataFilter checks a metadata filter expression; nil means no filter
+func validateMetadataFilter(filter *domain.MetadataFilter) error {
+	if filter == nil {
+		return nil
+	}
+	conditions := 0
+	return validateFilterNode(filter, 1, &conditions)
+}
+
+// validateFilterNode checks a filter and its nested filters, counting conditions
+func validateFilterNode(filter *domain.MetadataFilter, depth int, conditions *int) error {
+	*conditions++
+	if *conditions > maxFilterConditions {
+		return fmt.Errorf("%w: filter cannot have more than %d conditions", domain.ErrInvalidInput, maxFilterConditions)
+	}
+
+	switch filter.Op {
+	case domain.FilterAnd, domain.FilterOr:
+		if depth >= maxFilterDepth {
+			return fmt.Errorf("%w: filter cannot be nested deeper than %d levels", domain.ErrInvalidInput, maxFilterDepth)
+		}
+		if len(filter.Filters) == 0 {
+			return fmt.Errorf("%w: '%s' filter requires nested filters", domain.ErrInvalidInput, filter.Op)
+		}
+		for i := range filter.Filters {
+			if err := validateFilterNode(&filter.Filters[i], depth+1, conditions); err != nil {
+				return err
+			}
+		}
+		return nil
+	case domain.FilterEq, domain.FilterNe, domain.FilterContains:
+		if err := validateFilterValue(filter.Op, filter.Value); err != nil {
+			return err
+		}
+	case domain.FilterIn:
+		if len(filter.Values) == 0 {
+			return fmt.Errorf("%w: 'in' filter requires values", domain.ErrInvalidInput)
+		}
+		for _, value := range filter.Values {
+			if err := validateFilterValue(filter.Op, value); err != nil {
+				return err
+			}
+		}
+	case domain.FilterRange:
+		if err := validateRangeBounds(filter); err != nil {
+			return err
+		}
+	case domain.FilterExists:
+	default:
+		return fmt.Errorf("%w: unknown filter operator '%s'", domain.ErrInvalidInput, filter.Op)
+	}
+
+	_, err := filter.PathSegments()
+	return err
+}
+
+// validateFilterValue checks that a filter value is present and is valid JSON
+func validateFilterValue(op domain.FilterOperator, value json.RawMessage) error {
+	if len(value) == 0 {
+		return fmt.Errorf("%w: '%s' filter requires a value", domain.ErrInvalidInput, op)
+	}
+	if !json.Valid(value) {
+		return fmt.Errorf("%w: '%s' filter value is not valid JSON", domain.ErrInvalidInput, op)
+	}
+	return nil
+}
+
+// validateRangeBounds checks that a range filter has bounds of a single comparable type
+func validateRangeBounds(filter *domain.MetadataFilter) error {
+	boundType := ""
+	for _, bound := range []json.RawMessage{filter.Gt, filter.Gte, filter.Lt, filter.Lte} {
+		if len(bound) == 0 {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(bound, &value); err != nil {
+			return fmt.Errorf("%w: range bound is not valid JSON", domain.ErrInvalidInput)
+		}
+
+		var current string
+		switch value.(type) {
+		case float64:
+			current = "number"
+		case string:
+			current = "string"
+		default:
+			return fmt.Errorf("%w: range bounds must be numbers or strings", domain.ErrInvalidInput)
+		}
+
+		if boundType != "" && boundType != current {
+			return fmt.Errorf("%w: range bounds must be of the same type", domain.ErrInvalidInput)
+		}
+		boundType = current
+	}
+
+	if boundType == "" {
+		return fmt.Errorf("%w: 'range' filter requires at least one of gt, gte, lt, lte", domain.ErrInvalidInput)
+	}
+	return nil
+}
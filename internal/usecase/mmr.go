@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+// mmrCandidateFactor widens searches diversified with MMR, so that there are
+// less similar alternatives to choose from
+const mmrCandidateFactor = 4
+
+// validateMMRLambda checks the MMR trade-off between relevance and diversity
+func validateMMRLambda(lambda *float64) error {
+	if lambda != nil && (*lambda < 0 || *lambda > 1) {
+		return fmt.Errorf("%w: mmr_lambda must be between 0 and 1", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// selectMMR greedily selects up to n matches maximizing
+// lambda * relevance - (1 - lambda) * max similarity to already selected matches.
+// Relevance is the match score scaled to 0..1 over the candidates, so that fused scores
+// weigh the same as similarities; similarity between rules is the cosine of their vectors.
+func selectMMR(matches []*domain.RuleMatch, model string, lambda float64, n int) []*domain.RuleMatch {
+	if len(matches) == 0 {
+		return matches
+	}
+
+	minScore, maxScore := matches[0].Score, matches[0].Score
+	for _, match := range matches {
+		minScore = min(minScore, match.Score)
+		maxScore = max(maxScore, match.Score)
+	}
+	relevance := func(match *domain.RuleMatch) float64 {
+		if maxScore == minScore {
+			return 1
+		}
+		return (match.Score - minScore) / (maxScore - minScore)
+	}
+
+	remaining := append([]*domain.RuleMatch(nil), matches...)
+	// redundancy[i] is the highest similarity of remaining[i] to a selected match
+	redundancy := make([]float64, len(remaining))
+	selected := make([]*domain.RuleMatch, 0, min(n, len(matches)))
+
+	for len(selected) < n && len(remaining) > 0 {
+		best := 0
+		bestValue := 0.0
+		for i, match := range remaining {
+			value := lambda*relevance(match) - (1-lambda)*redundancy[i]
+			if i == 0 || value > bestValue {
+				best, bestValue = i, value
+			}
+		}
+
+		chosen := remaining[best]
+		selected = append(selected, chosen)
+		remaining = append(remaining[:best], remaining[best+1:]...)
+		redundancy = append(redundancy[:best], redundancy[best+1:]...)
+
+		chosenVector := chosen.EmbeddingFor(model)
+		for i, match := range remaining {
+			redundancy[i] = max(redundancy[i], embeddings.CosineSimilarity(chosenVector, match.EmbeddingFor(model)))
+		}
+	}
+
+	return selected
+}
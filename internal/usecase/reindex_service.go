@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+const (
+	defaultReindexBatchSize = 50
+	maxReindexBatchSize     = 1000
+	// reindexSaveTimeout bounds job state writes, which must succeed even during shutdown
+	reindexSaveTimeout = 5 * time.Second
+)
+
+type reindexService struct {
+	ruleRepo           domain.RuleRepository
+	ruleTypeRepo       domain.RuleTypeRepository
+	jobRepo            domain.ReindexJobRepository
+	embeddingProviders embeddingModels
+	chunking           ChunkingOptions
+
+	// baseCtx is canceled on shutdown; interrupted jobs stay running and are resumed on next start
+	baseCtx context.Context
+
+	mu      sync.Mutex
+	running map[int64]context.CancelFunc
+}
+
+// NewReindexService creates a new reindex service.
+// Jobs run in background goroutines bound to ctx.
+func NewReindexService(
+	ctx context.Context,
+	ruleRepo domain.RuleRepository,
+	ruleTypeRepo domain.RuleTypeRepository,
+	jobRepo domain.ReindexJobRepository,
+	chunking ChunkingOptions,
+	embeddingProvider domain.EmbeddingProvider,
+	additionalProviders ...domain.EmbeddingProvider,
+) domain.ReindexService {
+	return &reindexService{
+		ruleRepo:           ruleRepo,
+		ruleTypeRepo:       ruleTypeRepo,
+		jobRepo:            jobRepo,
+		embeddingProviders: newEmbeddingModels(embeddingProvider, additionalProviders),
+		chunking:           chunking,
+		baseCtx:            ctx,
+		running:            make(map[int64]context.CancelFunc),
+	}
+}
+
+func (s *reindexService) StartReindex(ctx context.Context, req *domain.StartReindexRequest) (*domain.ReindexJob, error) {
+	provider, err := s.embeddingProviders.providerFor(req.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := req.Scope
+	switch scope {
+	case "":
+		scope = domain.ReindexScopeStale
+	case domain.ReindexScopeAll, domain.ReindexScopeMissing, domain.ReindexScopeStale:
+	default:
+		return nil, fmt.Errorf("%w: unknown reindex scope '%s'", domain.ErrInvalidInput, scope)
+	}
+
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultReindexBatchSize
+	}
+	if batchSize > maxReindexBatchSize {
+		return nil, fmt.Errorf("%w: batch size must not exceed %d", domain.ErrInvalidInput, maxReindexBatchSize)
+	}
+	if req.MaxRulesPerSecond < 0 {
+		return nil, fmt.Errorf("%w: max rules per second must not be negative", domain.ErrInvalidInput)
+	}
+
+	filter := &domain.ReindexFilter{Model: provider.Model(), Scope: scope, RuleType: req.RuleType}
+	total, err := s.ruleRepo.CountForReindex(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count rules for reindex: %w", err)
+	}
+
+	job, err := s.jobRepo.Create(ctx, &domain.ReindexJob{
+		Model:             provider.Model(),
+		Scope:             scope,
+		RuleType:          req.RuleType,
+		BatchSize:         batchSize,
+		MaxRulesPerSecond: req.MaxRulesPerSecond,
+		Status:            domain.ReindexStatusRunning,
+		Total:             total,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reindex job: %w", err)
+	}
+
+	s.launch(job, provider)
+	return job, nil
+}
+
+func (s *reindexService) GetReindexJob(ctx context.Context, id int64) (*domain.ReindexJob, error) {
+	job, err := s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reindex job: %w", err)
+	}
+	return job, nil
+}
+
+func (s *reindexService) ListReindexJobs(ctx context.Context, limit, offset int) ([]*domain.ReindexJob, error) {
+	jobs, err := s.jobRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reindex jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+func (s *reindexService) CancelReindexJob(ctx context.Context, id int64) (*domain.ReindexJob, error) {
+	job, err := s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reindex job: %w", err)
+	}
+	if job.Status != domain.ReindexStatusRunning {
+		return nil, fmt.Errorf("%w: reindex job %d is already %s", domain.ErrInvalidInput, id, job.Status)
+	}
+
+	s.mu.Lock()
+	cancel, ok := s.running[id]
+	delete(s.running, id)
+	s.mu.Unlock()
+
+	if ok {
+		// The job goroutine notices the cancellation after the current batch
+		cancel()
+	}
+
+	// Mark as canceled right away; also covers jobs orphaned by another instance
+	now := time.Now()
+	job.Status = domain.ReindexStatusCanceled
+	job.FinishedAt = &now
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		if errors.Is(err, domain.ErrReindexJobNotRunning) {
+			// Completed or failed since it was read
+			return nil, fmt.Errorf("%w: reindex job %d is no longer running", domain.ErrInvalidInput, id)
+		}
+		return nil, fmt.Errorf("failed to cancel reindex job: %w", err)
+	}
+	return job, nil
+}
+
+func (s *reindexService) ReindexRuleType(ctx context.Context, ruleType string) ([]*domain.ReindexJob, error) {
+	jobs := make([]*domain.ReindexJob, 0, len(s.embeddingProviders))
+	for _, provider := range s.embeddingProviders {
+		model := provider.Model()
+		job, err := s.StartReindex(ctx, &domain.StartReindexRequest{
+			Model:    &model,
+			Scope:    domain.ReindexScopeStale,
+			RuleType: &ruleType,
+		})
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (s *reindexService) ResumeReindexJobs(ctx context.Context) error {
+	jobs, err := s.jobRepo.ListByStatus(ctx, domain.ReindexStatusRunning)
+	if err != nil {
+		return fmt.Errorf("failed to list interrupted reindex jobs: %w", err)
+	}
+
+	for _, job := range jobs {
+		provider, err := s.embeddingProviders.providerFor(&job.Model)
+		if err != nil {
+			// The model is no longer configured, the job cannot continue
+			s.finish(job, domain.ReindexStatusFailed, err)
+			continue
+		}
+
+		log.Printf("Resuming reindex job %d (model %s) after rule %d", job.ID, job.Model, job.LastRuleID)
+		s.launch(job, provider)
+	}
+
+	return nil
+}
+
+// launch runs the job in a background goroutine
+func (s *reindexService) launch(job *domain.ReindexJob, provider domain.EmbeddingProvider) {
+	ctx, cancel := context.WithCancel(s.baseCtx)
+
+	s.mu.Lock()
+	s.running[job.ID] = cancel
+	s.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.running, job.ID)
+			s.mu.Unlock()
+			cancel()
+		}()
+
+		s.run(ctx, job, provider)
+	}()
+}
+
+// run processes rules page by page starting after the job cursor
+func (s *reindexService) run(ctx context.Context, job *domain.ReindexJob, provider domain.EmbeddingProvider) {
+	filter := &domain.ReindexFilter{Model: job.Model, Scope: job.Scope, RuleType: job.RuleType}
+	ruleTypes := make(map[int64]*domain.RuleType)
+	started := time.Now()
+	startProcessed := job.Processed
+
+	for {
+		if ctx.Err() != nil {
+			// Canceled by the API (status already saved) or interrupted by shutdown (resumed later)
+			return
+		}
+
+		rules, err := s.ruleRepo.ListForReindex(ctx, filter, job.LastRuleID, job.BatchSize)
+		if err != nil {
+			s.fail(ctx, job, fmt.Errorf("failed to list rules: %w", err))
+			return
+		}
+		if len(rules) == 0 {
+			s.finish(job, domain.ReindexStatusCompleted, nil)
+			log.Printf("Reindex job %d completed: %d rules re-embedded", job.ID, job.Processed)
+			return
+		}
+
+		batch, chunkSets, err := s.chunkRules(ctx, rules, ruleTypes)
+		if err != nil {
+			s.fail(ctx, job, err)
+			return
+		}
+
+		var embedded []domain.RuleEmbedding
+		if len(batch) > 0 {
+			embedded, err = embedChunks(ctx, provider, chunkSets)
+			if err != nil {
+				s.fail(ctx, job, err)
+				return
+			}
+			// Vectors of a fallback model would be stored as another model, the job fails instead
+			if embedded[0].Model != job.Model {
+				s.fail(ctx, job, fmt.Errorf("embedding model %s is unavailable, fallback %s answered", job.Model, embedded[0].Model))
+				return
+			}
+		}
+
+		for i, rule := range batch {
+			err := s.ruleRepo.UpdateEmbedding(ctx, rule.ID, &embedded[i])
+			if err != nil && !errors.Is(err, domain.ErrRuleNotFound) {
+				s.fail(ctx, job, fmt.Errorf("failed to store embedding of rule %d: %w", rule.ID, err))
+				return
+			}
+		}
+
+		job.Processed += len(rules)
+		job.LastRuleID = rules[len(rules)-1].ID
+		if !s.save(job) {
+			return
+		}
+
+		s.throttle(ctx, job, started, job.Processed-startProcessed)
+	}
+}
+
+// chunkRules renders and chunks the text of every rule using the templates of their types.
+// Rules whose content does not fit the template are logged and skipped.
+func (s *reindexService) chunkRules(
+	ctx context.Context,
+	rules []*domain.Rule,
+	ruleTypes map[int64]*domain.RuleType,
+) ([]*domain.Rule, [][]string, error) {
+	batch := make([]*domain.Rule, 0, len(rules))
+	chunkSets := make([][]string, 0, len(rules))
+
+	for _, rule := range rules {
+		ruleType, ok := ruleTypes[rule.RuleTypeID]
+		if !ok {
+			var err error
+			ruleType, err = s.ruleTypeRepo.GetByID(ctx, rule.RuleTypeID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get rule type %d: %w", rule.RuleTypeID, err)
+			}
+			ruleTypes[rule.RuleTypeID] = ruleType
+		}
+
+		chunks, err := ruleChunks(ruleType, rule.Content, s.chunking)
+		if err != nil {
+			log.Printf("Skipping rule %d during reindex: %v", rule.ID, err)
+			continue
+		}
+
+		batch = append(batch, rule)
+		chunkSets = append(chunkSets, chunks)
+	}
+
+	return batch, chunkSets, nil
+}
+
+// throttle sleeps so that the job does not exceed its rules-per-second limit
+func (s *reindexService) throttle(ctx context.Context, job *domain.ReindexJob, started time.Time, processed int) {
+	if job.MaxRulesPerSecond <= 0 {
+		return
+	}
+
+	expected := time.Duration(float64(processed) / float64(job.MaxRulesPerSecond) * float64(time.Second))
+	wait := expected - time.Since(started)
+	if wait <= 0 {
+		return
+	}
+
+	select {
+	case <-time.After(wait):
+	case <-ctx.Done():
+	}
+}
+
+// fail marks the job as failed unless the error is caused by cancellation or shutdown
+func (s *reindexService) fail(ctx context.Context, job *domain.ReindexJob, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	log.Printf("Reindex job %d failed: %v", job.ID, err)
+	s.finish(job, domain.ReindexStatusFailed, err)
+}
+
+// finish sets a terminal status and saves the job
+func (s *reindexService) finish(job *domain.ReindexJob, status domain.ReindexStatus, err error) {
+	now := time.Now()
+	job.Status = status
+	job.FinishedAt = &now
+	if err != nil {
+		message := err.Error()
+		job.Error = &message
+	}
+	s.save(job)
+}
+
+// save persists job progress, reporting whether the job should continue.
+// A job canceled through the API in the meantime is not overwritten: the repository
+// writes only jobs still running.
+func (s *reindexService) save(job *domain.ReindexJob) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), reindexSaveTimeout)
+	defer cancel()
+
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		if !errors.Is(err, domain.ErrReindexJobNotRunning) {
+			log.Printf("Failed to save reindex job %d: %v", job.ID, err)
+		}
+		return false
+	}
+	return true
+}
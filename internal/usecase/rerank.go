@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// rerankCandidateFactor widens reranked searches, so that the reranker can promote
+// rules ranked below the top N by vector similarity
+const rerankCandidateFactor = 3
+
+// rerankMatches scores matches with the reranker against all queries of the request and
+// reorders them by the rerank score, keeping the retrieval score in VectorScore
+func (s *ruleService) rerankMatches(ctx context.Context, query *domain.RetrieveRulesQuery, matches []*domain.RuleMatch) ([]*domain.RuleMatch, error) {
+	if len(matches) == 0 {
+		return matches, nil
+	}
+
+	documents := make([]string, len(matches))
+	for i, match := range matches {
+		documents[i] = rerankDocument(match)
+	}
+
+	scores, err := s.reranker.Rerank(ctx, strings.Join(query.Queries, "\n"), documents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rerank rules with %s: %w", s.reranker.Name(), err)
+	}
+	if len(scores) != len(matches) {
+		return nil, fmt.Errorf("reranker %s returned %d scores for %d rules", s.reranker.Name(), len(scores), len(matches))
+	}
+
+	for i, match := range matches {
+		vectorScore, rerankScore := match.Score, scores[i]
+		match.VectorScore = &vectorScore
+		match.RerankScore = &rerankScore
+		match.Score = rerankScore
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches, nil
+}
+
+// rerankDocument returns the text a match is reranked by: the best chunk, or the raw
+// content for vectors migrated without chunk text
+func rerankDocument(match *domain.RuleMatch) string {
+	if match.Chunk != nil && match.Chunk.Text != "" {
+		return match.Chunk.Text
+	}
+	return string(match.Content)
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// RetrieveStream runs a retrieval like RetrieveSimilar, passing every match to emit in rank order.
+// A single search without reranking, diversification or quotas streams matches as the repository
+// reads them. Those stages and fusion of several searches need all candidates, so emission starts
+// once the ranking is final. An emit error stops the retrieval and is returned.
+func (s *ruleService) RetrieveStream(ctx context.Context, query *domain.RetrieveRulesQuery, emit func(*domain.RuleMatch) error) (*domain.RetrieveSummary, error) {
+	start := time.Now()
+
+	provider, err := s.prepareRetrieve(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	embeds, model, err := generateEmbeddings(ctx, provider, query.Queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate embeddings: %w", err)
+	}
+	embedded := time.Now()
+
+	var returned, total int
+	if streamsMatches(query) {
+		if returned, err = s.streamEmbedded(ctx, query, model, embeds, emit); err != nil {
+			return nil, err
+		}
+		total = returned
+	} else {
+		matches, candidates, err := s.retrieveEmbedded(ctx, query, model, embeds)
+		if err != nil {
+			return nil, err
+		}
+		if err := emitMatches(ctx, matches, emit); err != nil {
+			return nil, err
+		}
+		returned, total = len(matches), candidates
+	}
+	searched := time.Now()
+
+	return &domain.RetrieveSummary{
+		Returned:        returned,
+		TotalCandidates: total,
+		EmbeddingTime:   embedded.Sub(start),
+		SearchTime:      searched.Sub(embedded),
+		TotalTime:       time.Since(start),
+	}, nil
+}
+
+// streamsMatches reports whether matches of the query are final as the search finds them:
+// a single search whose ranking no later stage changes
+func streamsMatches(query *domain.RetrieveRulesQuery) bool {
+	return !query.Rerank && query.MMRLambda == nil && len(query.TypeQuotas) == 0 &&
+		(query.Fusion == "" || query.Fusion == domain.FusionAverage)
+}
+
+// streamEmbedded runs the single search of a streamed query, passing matches to emit as the repository
+// reads them. It returns the number of emitted matches.
+func (s *ruleService) streamEmbedded(ctx context.Context, query *domain.RetrieveRulesQuery, model string, embeds [][]float32, emit func(*domain.RuleMatch) error) (int, error) {
+	avgEmbedding, err := averageEmbeddings(embeds)
+	if err != nil {
+		return 0, fmt.Errorf("failed to average embeddings: %w", err)
+	}
+
+	search := s.similaritySearch(query, avgEmbedding, strings.Join(query.Queries, " "), model, query.N)
+	plan, err := s.searchPlan(ctx, query, search)
+	if err != nil {
+		return 0, err
+	}
+	filters := s.searchFilters(query)
+
+	returned := 0
+	var emitErr error
+	err = s.ruleRepo.StreamSimilar(ctx, search, func(match *domain.RuleMatch) error {
+		if emitErr = ctx.Err(); emitErr != nil {
+			return emitErr
+		}
+		returned++
+
+		if query.ScoreMode == domain.ScoreModeCalibrated {
+			match.Score = s.calibration.calibrate(match.Score)
+		}
+		if query.Explain {
+			match.Explanation = &domain.MatchExplanation{RetrievalRank: returned}
+			s.explainMatch(query, match, returned, filters, embeds, [][]float32{avgEmbedding}, plan)
+		}
+
+		emitErr = emit(match)
+		return emitErr
+	})
+	if emitErr != nil {
+		return 0, emitErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find similar rules: %w", err)
+	}
+
+	return returned, nil
+}
+
+// emitMatches passes ranked matches to emit in order
+func emitMatches(ctx context.Context, matches []*domain.RuleMatch, emit func(*domain.RuleMatch) error) error {
+	for _, match := range matches {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := emit(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+// streamingRuleRepository serves searches from a fixed list of matches, recording
+// how many matches the consumer had received when each of them was read.
+// Searches of fused queries run concurrently.
+type streamingRuleRepository struct {
+	domain.RuleRepository
+
+	matches  []*domain.RuleMatch
+	received atomic.Int64
+
+	mu   sync.Mutex
+	seen []int
+}
+
+func (r *streamingRuleRepository) read() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seen = append(r.seen, int(r.received.Load()))
+}
+
+func (r *streamingRuleRepository) FindSimilar(ctx context.Context, search *domain.SimilaritySearch) ([]*domain.RuleMatch, error) {
+	r.read()
+	matches := make([]*domain.RuleMatch, len(r.matches))
+	for i, match := range r.matches {
+		copied := *match
+		matches[i] = &copied
+	}
+	return matches, nil
+}
+
+func (r *streamingRuleRepository) StreamSimilar(ctx context.Context, search *domain.SimilaritySearch, emit func(*domain.RuleMatch) error) error {
+	for _, match := range r.matches {
+		r.read()
+		if err := emit(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *streamingRuleRepository) ExplainSimilar(ctx context.Context, search *domain.SimilaritySearch) ([]string, error) {
+	return nil, nil
+}
+
+func TestRetrieveStreamEmitsMatchesAsTheyAreRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    domain.RetrieveRulesQuery
+		wantSeen []int
+	}{
+		{
+			name:     "single search",
+			query:    domain.RetrieveRulesQuery{N: 3, Queries: []string{"email", "format"}},
+			wantSeen: []int{0, 1, 2},
+		},
+		{
+			name:     "type quotas",
+			query:    domain.RetrieveRulesQuery{N: 3, Queries: []string{"email"}, TypeQuotas: map[string]int{"validation": 3}},
+			wantSeen: []int{0},
+		},
+		{
+			name:     "rank fusion",
+			query:    domain.RetrieveRulesQuery{N: 3, Queries: []string{"email", "format"}, Fusion: domain.FusionRRF},
+			wantSeen: []int{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleType := "validation"
+			repo := &streamingRuleRepository{}
+			for id := int64(1); id <= 3; id++ {
+				repo.matches = append(repo.matches, &domain.RuleMatch{
+					Rule:  domain.Rule{ID: id, RuleTypeName: &ruleType},
+					Score: 1 / float64(id),
+				})
+			}
+			service := NewRuleService(repo, nil, ChunkingOptions{}, SearchOptions{}, ScoreCalibration{}, DedupOptions{}, nil,
+				embeddings.NewMockEmbeddingProvider(8))
+
+			summary, err := service.RetrieveStream(context.Background(), &tt.query, func(match *domain.RuleMatch) error {
+				repo.received.Add(1)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("RetrieveStream() error = %v", err)
+			}
+
+			if received := repo.received.Load(); summary.Returned != 3 || received != 3 {
+				t.Errorf("RetrieveStream() returned %d, emitted %d matches, want 3", summary.Returned, received)
+			}
+			// Fused searches read concurrently, in no particular order
+			seen := slices.Sorted(slices.Values(repo.seen))
+			if !slices.Equal(seen, tt.wantSeen) {
+				t.Errorf("matches received before each read = %v, want %v", seen, tt.wantSeen)
+			}
+		})
+	}
+}
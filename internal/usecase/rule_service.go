@@ -3,77 +3,305 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/ratmirtech/vector-rules-service/internal/domain"
-	"github.com/ratmirtech/vector-rules-service/internal/infra/embeddings"
+)
+
+const (
+	maxRetrieveResults = 100
+	maxRetrieveQueries = 32
 )
 
 type ruleService struct {
-	ruleRepo       domain.RuleRepository
-	ruleTypeRepo   domain.RuleTypeRepository
-	embeddingProvider domain.EmbeddingProvider
+	ruleRepo           domain.RuleRepository
+	ruleTypeRepo       domain.RuleTypeRepository
+	embeddingProvider  domain.EmbeddingProvider
+	embeddingProviders embeddingModels
+	chunking           ChunkingOptions
+	search             SearchOptions
+	calibration        ScoreCalibration
+	dedup              DedupOptions
+	reranker           domain.Reranker
 }
 
-// NewRuleService creates a new rule service
+// NewRuleService creates a new rule service.
+// embeddingProvider defines the default search model; additional providers are
+// written alongside it on create/update and can be selected per query, which
+// allows migrating to a new model without downtime. search holds search
+// defaults, dedup configures near-duplicate detection on create/update.
+// reranker may be nil when reranking is disabled.
 func NewRuleService(
 	ruleRepo domain.RuleRepository,
 	ruleTypeRepo domain.RuleTypeRepository,
+	chunking ChunkingOptions,
+	search SearchOptions,
+	calibration ScoreCalibration,
+	dedup DedupOptions,
+	reranker domain.Reranker,
 	embeddingProvider domain.EmbeddingProvider,
+	additionalProviders ...domain.EmbeddingProvider,
 ) domain.RuleService {
 	return &ruleService{
-		ruleRepo:          ruleRepo,
-		ruleTypeRepo:      ruleTypeRepo,
-		embeddingProvider: embeddingProvider,
+		ruleRepo:           ruleRepo,
+		ruleTypeRepo:       ruleTypeRepo,
+		embeddingProvider:  embeddingProvider,
+		embeddingProviders: newEmbeddingModels(embeddingProvider, additionalProviders),
+		chunking:           chunking,
+		search:             search,
+		calibration:        calibration,
+		dedup:              dedup,
+		reranker:           reranker,
 	}
 }
 
 func (s *ruleService) RetrieveSimilar(ctx context.Context, query *domain.RetrieveRulesQuery) ([]*domain.RuleMatch, error) {
-	// Generate embeddings for all queries
-	embeds, err := s.embeddingProvider.GenerateBatchEmbeddings(ctx, query.Queries)
+	provider, err := s.prepareRetrieve(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate embeddings for all queries, searching vectors of the model that produced them
+	embeds, model, err := generateEmbeddings(ctx, provider, query.Queries)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate embeddings: %w", err)
 	}
 
-	// Average all query embeddings into a single embedding
-	avgEmbedding, err := embeddings.AverageEmbeddings(embeds)
+	matches, _, err := s.retrieveEmbedded(ctx, query, model, embeds)
+	return matches, err
+}
+
+// prepareRetrieve checks a retrieval query and returns the embedding provider of its model
+func (s *ruleService) prepareRetrieve(ctx context.Context, query *domain.RetrieveRulesQuery) (domain.EmbeddingProvider, error) {
+	if err := validateRetrieveQuery(query); err != nil {
+		return nil, err
+	}
+	if err := s.calibration.validateScoreMode(query); err != nil {
+		return nil, err
+	}
+	if query.Rerank && s.reranker == nil {
+		return nil, fmt.Errorf("%w: reranking is not configured", domain.ErrInvalidInput)
+	}
+
+	if err := s.checkRuleTypes(ctx, retrieveTypeFilter(query)); err != nil {
+		return nil, err
+	}
+
+	return s.embeddingProviders.providerFor(query.Model)
+}
+
+// retrieveEmbedded searches rules for a checked query with the embeddings of its queries.
+// It also returns the number of ranked candidates the matches were selected from.
+func (s *ruleService) retrieveEmbedded(ctx context.Context, query *domain.RetrieveRulesQuery, model string, embeds [][]float32) ([]*domain.RuleMatch, int, error) {
+	// Searches with type quotas fetch more candidates to fill places skipped over quota,
+	// reranked and diversified searches fetch more alternatives to choose from
+	candidates := query.N
+	if len(query.TypeQuotas) > 0 {
+		candidates *= quotaCandidateFactor
+	}
+	fetch := candidates
+	if query.Rerank {
+		fetch *= rerankCandidateFactor
+	}
+	if query.MMRLambda != nil {
+		fetch *= mmrCandidateFactor
+	}
+
+	var matches []*domain.RuleMatch
+	var searched [][]float32
+	var plan []string
+	if query.Fusion == "" || query.Fusion == domain.FusionAverage {
+		// Average all query embeddings into a single embedding
+		avgEmbedding, err := averageEmbeddings(embeds)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to average embeddings: %w", err)
+		}
+		searched = [][]float32{avgEmbedding}
+
+		search := s.similaritySearch(query, avgEmbedding, strings.Join(query.Queries, " "), model, fetch)
+		if matches, err = s.findSimilar(ctx, query, search); err != nil {
+			return nil, 0, err
+		}
+		if plan, err = s.searchPlan(ctx, query, search); err != nil {
+			return nil, 0, err
+		}
+	} else {
+		// Search every query separately and fuse the result lists
+		searched = embeds
+		searches := make([]*domain.SimilaritySearch, len(embeds))
+		resultSets := make([][]*domain.RuleMatch, len(embeds))
+		errs := make([]error, len(embeds))
+		var wg sync.WaitGroup
+		for i, embedding := range embeds {
+			searches[i] = s.similaritySearch(query, embedding, query.Queries[i], model, fetch*fusionCandidateFactor)
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				resultSets[i], errs[i] = s.findSimilar(ctx, query, searches[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+
+		matches = fuseMatches(query.Fusion, resultSets, fetch)
+		var err error
+		if plan, err = s.searchPlan(ctx, query, searches[0]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if query.Explain {
+		markRetrievalRanks(matches)
+	}
+
+	total := len(matches)
+	matches, err := s.selectMatches(ctx, query, matches, model, candidates)
 	if err != nil {
-		return nil, fmt.Errorf("failed to average embeddings: %w", err)
+		return nil, 0, err
+	}
+
+	if query.Explain {
+		s.explainMatches(query, matches, embeds, searched, plan)
+	}
+
+	return matches, total, nil
+}
+
+// selectMatches reranks and diversifies ranked candidates down to the given number if requested,
+// then applies type quotas to return up to N matches
+func (s *ruleService) selectMatches(ctx context.Context, query *domain.RetrieveRulesQuery, matches []*domain.RuleMatch, model string, candidates int) ([]*domain.RuleMatch, error) {
+	if query.Rerank {
+		var err error
+		if matches, err = s.rerankMatches(ctx, query, matches); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.MMRLambda != nil {
+		matches = selectMMR(matches, model, *query.MMRLambda, candidates)
+		// Vectors were loaded for diversification only
+		for _, match := range matches {
+			match.Embeddings = nil
+		}
+	}
+	return applyTypeQuotas(matches, query.TypeQuotas, query.N), nil
+}
+
+// validateRetrieveQuery checks retrieval parameters
+func validateRetrieveQuery(query *domain.RetrieveRulesQuery) error {
+	if query.N < 1 || query.N > maxRetrieveResults {
+		return fmt.Errorf("%w: n must be between 1 and %d", domain.ErrInvalidInput, maxRetrieveResults)
 	}
+	if len(query.Queries) == 0 {
+		return fmt.Errorf("%w: queries cannot be empty", domain.ErrInvalidInput)
+	}
+	if len(query.Queries) > maxRetrieveQueries {
+		return fmt.Errorf("%w: at most %d queries are allowed", domain.ErrInvalidInput, maxRetrieveQueries)
+	}
+	for i, q := range query.Queries {
+		if strings.TrimSpace(q) == "" {
+			return fmt.Errorf("%w: query %d cannot be blank", domain.ErrInvalidInput, i)
+		}
+	}
+	if err := validateFusion(query.Fusion); err != nil {
+		return err
+	}
+	if err := validateSearchMode(query.Mode, query.Alpha); err != nil {
+		return err
+	}
+	if err := validateDistanceMetric(query.Metric); err != nil {
+		return err
+	}
+	if err := validateTypeQuotas(query.TypeQuotas); err != nil {
+		return err
+	}
+	if err := validateMMRLambda(query.MMRLambda); err != nil {
+		return err
+	}
+	return validateMetadataFilter(query.Filter)
+}
+
+// similaritySearch builds the search of rules similar to a single embedding; text is matched lexically in hybrid modes
+func (s *ruleService) similaritySearch(query *domain.RetrieveRulesQuery, embedding []float32, text, model string, limit int) *domain.SimilaritySearch {
+	return &domain.SimilaritySearch{
+		Embedding: embedding,
+		Model:     model,
+		Types:     retrieveTypeFilter(query),
+		Limit:     limit,
+		Metric:    s.distanceMetric(query.Metric),
+		Mode:      query.Mode,
+		Text:      text,
+		Alpha:     hybridAlpha(query.Alpha),
+		MinScore:  s.calibration.minRawScore(query),
+		Filter:    query.Filter,
 
-	// Find similar rules using the averaged embedding
-	matches, err := s.ruleRepo.FindSimilar(ctx, avgEmbedding, query.Type, query.N)
+		IncludeEmbeddings:      query.MMRLambda != nil,
+		IncludeChunkEmbeddings: query.Explain,
+	}
+}
+
+// findSimilar runs a search of the query.
+// Scores are calibrated before fusion, so max and sum combine calibrated scores.
+func (s *ruleService) findSimilar(ctx context.Context, query *domain.RetrieveRulesQuery, search *domain.SimilaritySearch) ([]*domain.RuleMatch, error) {
+	matches, err := s.ruleRepo.FindSimilar(ctx, search)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find similar rules: %w", err)
 	}
 
+	if query.ScoreMode == domain.ScoreModeCalibrated {
+		for _, match := range matches {
+			match.Score = s.calibration.calibrate(match.Score)
+		}
+	}
+
 	return matches, nil
 }
 
 func (s *ruleService) CreateRule(ctx context.Context, req *domain.CreateRuleRequest) (*domain.Rule, error) {
+	policy, err := s.dedupPolicy(req.DedupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate and get rule type
 	ruleType, err := s.ruleTypeRepo.GetByName(ctx, req.Type)
 	if err != nil {
 		return nil, fmt.Errorf("invalid rule type '%s': %w", req.Type, err)
 	}
 
-	// Generate embedding for the content
-	contentStr := string(req.Content)
-	embedding, err := s.embeddingProvider.GenerateEmbedding(ctx, contentStr)
+	// Generate embeddings for chunks of the text extracted from the content
+	chunks, err := ruleChunks(ruleType, req.Content, s.chunking)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate embedding: %w", err)
+		return nil, err
+	}
+	ruleEmbeddings, err := s.generateRuleEmbeddings(ctx, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates, err := s.findDuplicates(ctx, policy, ruleType.ID, 0, ruleEmbeddings)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create rule
 	rule := &domain.Rule{
 		RuleTypeID: ruleType.ID,
 		Content:    req.Content,
-		Embedding:  embedding,
+		Embeddings: ruleEmbeddings,
 	}
 
 	createdRule, err := s.ruleRepo.Create(ctx, rule)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rule: %w", err)
 	}
+	createdRule.PossibleDuplicates = duplicates
 
 	return createdRule, nil
 }
@@ -87,6 +315,11 @@ func (s *ruleService) GetRule(ctx context.Context, id int64) (*domain.Rule, erro
 }
 
 func (s *ruleService) UpdateRule(ctx context.Context, req *domain.UpdateRuleRequest) (*domain.Rule, error) {
+	policy, err := s.dedupPolicy(req.DedupPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate and get rule type
 	ruleType, err := s.ruleTypeRepo.GetByName(ctx, req.Type)
 	if err != nil {
@@ -99,22 +332,31 @@ func (s *ruleService) UpdateRule(ctx context.Context, req *domain.UpdateRuleRequ
 		return nil, fmt.Errorf("failed to get existing rule: %w", err)
 	}
 
-	// Generate new embedding for updated content
-	contentStr := string(req.Content)
-	embedding, err := s.embeddingProvider.GenerateEmbedding(ctx, contentStr)
+	// Generate new embeddings for chunks of the text extracted from updated content
+	chunks, err := ruleChunks(ruleType, req.Content, s.chunking)
+	if err != nil {
+		return nil, err
+	}
+	ruleEmbeddings, err := s.generateRuleEmbeddings(ctx, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates, err := s.findDuplicates(ctx, policy, ruleType.ID, existingRule.ID, ruleEmbeddings)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate embedding: %w", err)
+		return nil, err
 	}
 
 	// Update rule
 	existingRule.RuleTypeID = ruleType.ID
 	existingRule.Content = req.Content
-	existingRule.Embedding = embedding
+	existingRule.Embeddings = ruleEmbeddings
 
 	updatedRule, err := s.ruleRepo.Update(ctx, existingRule)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update rule: %w", err)
 	}
+	updatedRule.PossibleDuplicates = duplicates
 
 	return updatedRule, nil
 }
@@ -127,10 +369,33 @@ func (s *ruleService) DeleteRule(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *ruleService) ListRules(ctx context.Context, ruleType *string, limit, offset int) ([]*domain.Rule, error) {
-	rules, err := s.ruleRepo.List(ctx, ruleType, limit, offset)
+func (s *ruleService) ListRules(ctx context.Context, types *domain.RuleTypeFilter, filter *domain.MetadataFilter, limit, offset int) ([]*domain.Rule, error) {
+	if err := validateMetadataFilter(filter); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.List(ctx, types, filter, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list rules: %w", err)
 	}
 	return rules, nil
-}
\ No newline at end of file
+}
+
+// generateRuleEmbeddings embeds the chunks with every configured model, the default one first.
+// When a fallback answers for a model that is embedded anyway, its vectors are stored once.
+func (s *ruleService) generateRuleEmbeddings(ctx context.Context, chunks []string) ([]domain.RuleEmbedding, error) {
+	ruleEmbeddings := make([]domain.RuleEmbedding, 0, len(s.embeddingProviders))
+	models := make(map[string]bool, len(s.embeddingProviders))
+	for _, provider := range s.embeddingProviders {
+		embedded, err := embedChunks(ctx, provider, [][]string{chunks})
+		if err != nil {
+			return nil, err
+		}
+		if models[embedded[0].Model] {
+			continue
+		}
+		models[embedded[0].Model] = true
+		ruleEmbeddings = append(ruleEmbeddings, embedded[0])
+	}
+	return ruleEmbeddings, nil
+}
@@ -3,24 +3,35 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log"
+	"slices"
 
 	"github.com/ratmirtech/vector-rules-service/internal/domain"
 )
 
 type ruleTypeService struct {
-	ruleTypeRepo domain.RuleTypeRepository
+	ruleTypeRepo   domain.RuleTypeRepository
+	reindexService domain.ReindexService
 }
 
-// NewRuleTypeService creates a new rule type service
-func NewRuleTypeService(ruleTypeRepo domain.RuleTypeRepository) domain.RuleTypeService {
+// NewRuleTypeService creates a new rule type service.
+// reindexService re-embeds rules when the embedding template of their type changes.
+func NewRuleTypeService(ruleTypeRepo domain.RuleTypeRepository, reindexService domain.ReindexService) domain.RuleTypeService {
 	return &ruleTypeService{
-		ruleTypeRepo: ruleTypeRepo,
+		ruleTypeRepo:   ruleTypeRepo,
+		reindexService: reindexService,
 	}
 }
 
 func (s *ruleTypeService) CreateRuleType(ctx context.Context, req *domain.CreateRuleTypeRequest) (*domain.RuleType, error) {
+	if err := validateEmbeddingTemplate(req.EmbeddingFields, req.EmbeddingTemplate); err != nil {
+		return nil, err
+	}
+
 	ruleType := &domain.RuleType{
-		Name: req.Name,
+		Name:              req.Name,
+		EmbeddingFields:   req.EmbeddingFields,
+		EmbeddingTemplate: req.EmbeddingTemplate,
 	}
 
 	createdRuleType, err := s.ruleTypeRepo.Create(ctx, ruleType)
@@ -40,20 +51,56 @@ func (s *ruleTypeService) GetRuleType(ctx context.Context, id int64) (*domain.Ru
 }
 
 func (s *ruleTypeService) UpdateRuleType(ctx context.Context, req *domain.UpdateRuleTypeRequest) (*domain.RuleType, error) {
+	if err := validateEmbeddingTemplate(req.EmbeddingFields, req.EmbeddingTemplate); err != nil {
+		return nil, err
+	}
+	if req.ClearEmbeddingFields && len(req.EmbeddingFields) > 0 {
+		return nil, fmt.Errorf("%w: embedding fields cannot be set and cleared at once", domain.ErrInvalidInput)
+	}
+	if req.ClearEmbeddingTemplate && req.EmbeddingTemplate != nil {
+		return nil, fmt.Errorf("%w: embedding template cannot be set and cleared at once", domain.ErrInvalidInput)
+	}
+
 	// Check if rule type exists
 	existingRuleType, err := s.ruleTypeRepo.GetByID(ctx, req.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get existing rule type: %w", err)
 	}
 
+	// Omitted extraction settings are kept
+	fields, tmpl := existingRuleType.EmbeddingFields, existingRuleType.EmbeddingTemplate
+	if req.EmbeddingFields != nil || req.ClearEmbeddingFields {
+		fields = req.EmbeddingFields
+	}
+	if req.EmbeddingTemplate != nil || req.ClearEmbeddingTemplate {
+		tmpl = req.EmbeddingTemplate
+	}
+
+	templateChanged := !slices.Equal(existingRuleType.EmbeddingFields, fields) ||
+		!equalStringPtr(existingRuleType.EmbeddingTemplate, tmpl)
+
 	// Update rule type
 	existingRuleType.Name = req.Name
+	existingRuleType.EmbeddingFields = fields
+	existingRuleType.EmbeddingTemplate = tmpl
 
 	updatedRuleType, err := s.ruleTypeRepo.Update(ctx, existingRuleType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update rule type: %w", err)
 	}
 
+	// Rules embedded with the previous template are stale now. The update is already saved,
+	// so a failed start does not fail it: the rules stay in scope of the next stale reindex.
+	if templateChanged && req.Reindex && s.reindexService != nil {
+		jobs, err := s.reindexService.ReindexRuleType(ctx, updatedRuleType.Name)
+		for _, job := range jobs {
+			log.Printf("Started reindex job %d for rule type '%s' (model %s)", job.ID, updatedRuleType.Name, job.Model)
+		}
+		if err != nil {
+			log.Printf("Failed to start reindex of rule type '%s': %v", updatedRuleType.Name, err)
+		}
+	}
+
 	return updatedRuleType, nil
 }
 
@@ -71,4 +118,12 @@ func (s *ruleTypeService) ListRuleTypes(ctx context.Context, limit, offset int)
 		return nil, fmt.Errorf("failed to list rule types: %w", err)
 	}
 	return ruleTypes, nil
-}
\ No newline at end of file
+}
+
+// equalStringPtr reports whether two optional strings are equal
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// memoryRuleTypeRepository keeps a single rule type in memory
+type memoryRuleTypeRepository struct {
+	domain.RuleTypeRepository
+	ruleType domain.RuleType
+}
+
+func (r *memoryRuleTypeRepository) GetByID(ctx context.Context, id int64) (*domain.RuleType, error) {
+	ruleType := r.ruleType
+	return &ruleType, nil
+}
+
+func (r *memoryRuleTypeRepository) Update(ctx context.Context, ruleType *domain.RuleType) (*domain.RuleType, error) {
+	r.ruleType = *ruleType
+	updated := r.ruleType
+	return &updated, nil
+}
+
+// failingReindexService cannot start reindex jobs
+type failingReindexService struct {
+	domain.ReindexService
+	calls int
+}
+
+func (s *failingReindexService) ReindexRuleType(ctx context.Context, ruleType string) ([]*domain.ReindexJob, error) {
+	s.calls++
+	return nil, errors.New("reindex jobs are unavailable")
+}
+
+func TestUpdateRuleTypeKeepsUpdateWhenReindexFails(t *testing.T) {
+	repo := &memoryRuleTypeRepository{ruleType: domain.RuleType{ID: 1, Name: "validation"}}
+	reindex := &failingReindexService{}
+	service := NewRuleTypeService(repo, reindex)
+
+	tmpl := "{{.description}}"
+	updated, err := service.UpdateRuleType(context.Background(), &domain.UpdateRuleTypeRequest{
+		ID:                1,
+		Name:              "validation",
+		EmbeddingTemplate: &tmpl,
+		Reindex:           true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateRuleType() error = %v, want the saved update", err)
+	}
+
+	if reindex.calls != 1 {
+		t.Errorf("ReindexRuleType() called %d times, want 1", reindex.calls)
+	}
+	if updated.EmbeddingTemplate == nil || *updated.EmbeddingTemplate != tmpl {
+		t.Errorf("UpdateRuleType() template = %v, want %q", updated.EmbeddingTemplate, tmpl)
+	}
+	if repo.ruleType.EmbeddingTemplate == nil || *repo.ruleType.EmbeddingTemplate != tmpl {
+		t.Error("template was not saved")
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// ScoreCalibration maps raw similarity to 0..1 with a logistic curve.
+// Midpoint is the raw score mapped to 0.5, Steepness controls how fast scores
+// around it approach 0 and 1. Parameters are tuned per embedding model.
+type ScoreCalibration struct {
+	Midpoint  float64
+	Steepness float64
+}
+
+// enabled reports whether calibrated scores are configured
+func (c ScoreCalibration) enabled() bool {
+	return c.Steepness > 0
+}
+
+// calibrate maps a raw score to 0..1
+func (c ScoreCalibration) calibrate(score float64) float64 {
+	return 1 / (1 + math.Exp(-c.Steepness*(score-c.Midpoint)))
+}
+
+// rawThreshold returns the raw score calibrated to p, the inverse of calibrate
+func (c ScoreCalibration) rawThreshold(p float64) float64 {
+	return c.Midpoint - math.Log(1/p-1)/c.Steepness
+}
+
+// validateScoreMode checks the score mode and threshold of a query
+func (c ScoreCalibration) validateScoreMode(query *domain.RetrieveRulesQuery) error {
+	switch query.ScoreMode {
+	case "", domain.ScoreModeRaw:
+		if query.MinScore != nil && (math.IsNaN(*query.MinScore) || *query.MinScore < -1 || *query.MinScore > 1) {
+			return fmt.Errorf("%w: min_score must be between -1 and 1", domain.ErrInvalidInput)
+		}
+	case domain.ScoreModeCalibrated:
+		if !c.enabled() {
+			return fmt.Errorf("%w: score calibration is not configured", domain.ErrInvalidInput)
+		}
+		if query.Mode == domain.SearchModeHybridRRF {
+			return fmt.Errorf("%w: calibrated scores are not available in %s mode", domain.ErrInvalidInput, query.Mode)
+		}
+		if query.MinScore != nil && (math.IsNaN(*query.MinScore) || *query.MinScore < 0 || *query.MinScore >= 1) {
+			return fmt.Errorf("%w: calibrated min_score must be at least 0 and below 1", domain.ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("%w: unknown score mode '%s'", domain.ErrInvalidInput, query.ScoreMode)
+	}
+
+	return nil
+}
+
+// minRawScore converts the threshold of a query to the raw scale filtered in SQL, or nil for no threshold
+func (c ScoreCalibration) minRawScore(query *domain.RetrieveRulesQuery) *float64 {
+	if query.MinScore == nil {
+		return nil
+	}
+	if query.ScoreMode != domain.ScoreModeCalibrated {
+		return query.MinScore
+	}
+	if *query.MinScore <= 0 {
+		return nil
+	}
+
+	threshold := c.rawThreshold(*query.MinScore)
+	return &threshold
+}
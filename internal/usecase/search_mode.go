@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// defaultHybridAlpha weighs vector similarity and text rank equally
+const defaultHybridAlpha = 0.5
+
+// SearchOptions holds defaults of similarity searches
+type SearchOptions struct {
+	Metric       domain.DistanceMetric // Metric of queries without one
+	ExplainPlans bool                  // Add execution plans of search queries to explained matches
+}
+
+// validateSearchMode checks the search mode and hybrid weight of a query
+func validateSearchMode(mode domain.SearchMode, alpha *float64) error {
+	switch mode {
+	case "", domain.SearchModeVector, domain.SearchModeHybrid, domain.SearchModeHybridRRF:
+	default:
+		return fmt.Errorf("%w: unknown search mode '%s'", domain.ErrInvalidInput, mode)
+	}
+
+	if alpha != nil && (*alpha < 0 || *alpha > 1) {
+		return fmt.Errorf("%w: alpha must be between 0 and 1", domain.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// hybridAlpha returns the weight of vector similarity in hybrid modes
+func hybridAlpha(alpha *float64) float64 {
+	if alpha == nil {
+		return defaultHybridAlpha
+	}
+	return *alpha
+}
+
+// validateDistanceMetric checks the distance metric of a query
+func validateDistanceMetric(metric domain.DistanceMetric) error {
+	if metric != "" && !metric.IsValid() {
+		return fmt.Errorf("%w: unknown distance metric '%s'", domain.ErrInvalidInput, metric)
+	}
+	return nil
+}
+
+// distanceMetric returns the requested metric, defaulting to the configured one
+func (s *ruleService) distanceMetric(metric domain.DistanceMetric) domain.DistanceMetric {
+	if metric == "" {
+		return s.search.Metric
+	}
+	return metric
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// FindSimilarRules searches with the stored pooled vector of the rule, so no embedding is generated
+func (s *ruleService) FindSimilarRules(ctx context.Context, query *domain.SimilarRulesQuery) ([]*domain.RuleMatch, error) {
+	if query.N < 1 || query.N > maxRetrieveResults {
+		return nil, fmt.Errorf("%w: n must be between 1 and %d", domain.ErrInvalidInput, maxRetrieveResults)
+	}
+	if err := validateDistanceMetric(query.Metric); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.embeddingProviders.providerFor(query.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.ruleRepo.GetByID(ctx, query.RuleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule: %w", err)
+	}
+
+	embedding := rule.EmbeddingFor(provider.Model())
+	if embedding == nil {
+		return nil, fmt.Errorf("%w: rule %d has no embedding of model '%s', reindex it first", domain.ErrInvalidInput, rule.ID, provider.Model())
+	}
+
+	if !query.Types.IsEmpty() {
+		if err := s.checkRuleTypes(ctx, query.Types); err != nil {
+			return nil, err
+		}
+	}
+
+	matches, err := s.ruleRepo.FindSimilar(ctx, &domain.SimilaritySearch{
+		Embedding:  embedding,
+		Model:      provider.Model(),
+		Types:      query.Types,
+		Limit:      query.N,
+		Metric:     s.distanceMetric(query.Metric),
+		ExcludeIDs: []int64{rule.ID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar rules: %w", err)
+	}
+
+	return matches, nil
+}
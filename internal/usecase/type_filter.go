@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ratmirtech/vector-rules-service/internal/domain"
+)
+
+// quotaCandidateFactor widens searches with type quotas, so that rules of other types
+// can fill the places of rules skipped over their type quota
+const quotaCandidateFactor = 3
+
+// retrieveTypeFilter collects the rule types of a query; the single Type is included like Types
+func retrieveTypeFilter(query *domain.RetrieveRulesQuery) *domain.RuleTypeFilter {
+	types := &domain.RuleTypeFilter{
+		Include:    query.Types,
+		IncludeIDs: query.TypeIDs,
+		Exclude:    query.ExcludeTypes,
+		ExcludeIDs: query.ExcludeTypeIDs,
+	}
+	if query.Type != nil {
+		types.Include = append([]string{*query.Type}, query.Types...)
+	}
+	return types
+}
+
+// checkRuleTypes verifies that the included rule types exist, since a misspelled
+// type would silently match no rules
+func (s *ruleService) checkRuleTypes(ctx context.Context, types *domain.RuleTypeFilter) error {
+	for _, name := range types.Include {
+		if _, err := s.ruleTypeRepo.GetByName(ctx, name); err != nil {
+			return fmt.Errorf("invalid rule type '%s': %w", name, err)
+		}
+	}
+	for _, id := range types.IncludeIDs {
+		if _, err := s.ruleTypeRepo.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("invalid rule type %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// validateTypeQuotas checks per-type result limits
+func validateTypeQuotas(quotas map[string]int) error {
+	for name, quota := range quotas {
+		if quota < 1 {
+			return fmt.Errorf("%w: quota of rule type '%s' must be at least 1, use exclude_types to skip a type", domain.ErrInvalidInput, name)
+		}
+	}
+	return nil
+}
+
+// applyTypeQuotas keeps the best matches up to n, skipping matches of types over their quota
+func applyTypeQuotas(matches []*domain.RuleMatch, quotas map[string]int, n int) []*domain.RuleMatch {
+	if len(quotas) == 0 {
+		if len(matches) > n {
+			matches = matches[:n]
+		}
+		return matches
+	}
+
+	counts := make(map[string]int, len(quotas))
+	result := make([]*domain.RuleMatch, 0, n)
+	for _, match := range matches {
+		if len(result) == n {
+			break
+		}
+		if match.RuleTypeName != nil {
+			name := *match.RuleTypeName
+			if quota, ok := quotas[name]; ok {
+				if counts[name] >= quota {
+					continue
+				}
+				counts[name]++
+			}
+		}
+		result = append(result, match)
+	}
+	return result
+}